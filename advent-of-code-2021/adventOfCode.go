@@ -1,52 +1,277 @@
 package main
 
 import (
-	"advent-of-code-2021/day1"
-	"advent-of-code-2021/day10"
-	"advent-of-code-2021/day11"
-	"advent-of-code-2021/day2"
-	"advent-of-code-2021/day3"
-	"advent-of-code-2021/day4"
-	"advent-of-code-2021/day5"
-	"advent-of-code-2021/day6"
-	"advent-of-code-2021/day7"
-	"advent-of-code-2021/day8"
-	"advent-of-code-2021/day9"
+	"advent-of-code/answer"
+	"advent-of-code/registry"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"os"
+	"time"
 )
 
-type fn func(inputFilePath string) (string, error)
-
 func main() {
-	execute(1, 1, day1.Part1, "day1/measurements.csv")
-	execute(1, 2, day1.Part2, "day1/measurements.csv")
-	execute(2, 1, day2.Part1, "day2/commands.csv")
-	execute(2, 2, day2.Part2, "day2/commands.csv")
-	execute(3, 1, day3.Part1, "day3/diagnostics.csv")
-	execute(3, 2, day3.Part2, "day3/diagnostics.csv")
-	execute(4, 1, day4.Part1, "day4/game.txt")
-	execute(4, 2, day4.Part2, "day4/game.txt")
-	execute(5, 1, day5.Part1, "day5/cent_coordinates.txt")
-	execute(5, 2, day5.Part2, "day5/cent_coordinates.txt")
-	execute(6, 1, day6.Part1, "day6/lanternfish.csv")
-	execute(6, 2, day6.Part2, "day6/lanternfish.csv")
-	execute(7, 1, day7.Part1, "day7/crab_positions.csv")
-	execute(7, 2, day7.Part2, "day7/crab_positions.csv")
-	execute(8, 1, day8.Part1, "day8/signal_patterns.txt")
-	execute(8, 2, day8.Part2, "day8/signal_patterns.txt")
-	execute(9, 1, day9.Part1, "day9/heightmap.txt")
-	execute(9, 2, day9.Part2, "day9/heightmap.txt")
-	execute(10, 1, day10.Part1, "day10/input.txt")
-	execute(10, 2, day10.Part2, "day10/input.txt")
-	execute(11, 1, day11.Part1, "day11/octopuses.txt")
-	execute(11, 2, day11.Part2, "day11/octopuses.txt")
-}
-
-func execute(day, part int, task fn, filePath string) {
-	fmt.Printf("Day %d, part %d:\n", day, part)
-	answer, err := task(filePath)
+	if len(os.Args) > 1 {
+		if command, found := commands[os.Args[1]]; found {
+			os.Exit(command(os.Args[2:]))
+		}
+	}
+
+	flag.Usage = printUsage
+	year := flag.Int("year", 0, "the year to run. Defaults to the latest year with -day, and every year with -all")
+	daySpec := flag.String("day", "", "the day(s) to run, e.g. 9, 3-7 or 1,4-6")
+	part := flag.Int("part", 0, "the part to run (1 or 2). Both parts are run if omitted")
+	all := flag.Bool("all", false, "run every day")
+	inputFilePath := flag.String("input", "", "an input file to use instead of each day's default input, or - for stdin")
+	useSample := flag.Bool("sample", false, "use each day's sample input (its test.* file) instead of its default input")
+	failFast := flag.Bool("fail-fast", false, "stop at the first part that fails")
+	verify := flag.Bool("verify", false, "compare each answer to the known-correct answer in the answers file")
+	answersFilePath := flag.String("answers", "answers.json", "the file containing known-correct answers")
+	showTiming := flag.Bool("time", false, "report how long each part took and how much memory it allocated")
+	benchmarkRuns := flag.Int("bench", 0, "run each part this many times and report the min, median and max durations")
+	timeout := flag.Duration("timeout", 0, "abandon any part that runs for longer than this, e.g. 30s. No limit if omitted")
+	workers := flag.Int("parallel", 1, "the number of parts to run at once. Allocation counts include all parts that overlap")
+	outputFormat := flag.String("output", textOutput, "the format to write results in: text, json or csv")
+	flag.Parse()
+
+	selection, err := newSelection(*year, *daySpec, *part, *all, registry.LatestYear())
+	if err == nil && *useSample && *inputFilePath != "" {
+		err = errors.New("-sample cannot be combined with -input")
+	}
+	if err == nil && *outputFormat != textOutput && *outputFormat != jsonOutput && *outputFormat != csvOutput {
+		err = fmt.Errorf("unsupported output format %q", *outputFormat)
+	}
+	if err == nil && *benchmarkRuns < 0 {
+		err = fmt.Errorf("-bench must not be negative, but was %d", *benchmarkRuns)
+	}
+	if err == nil && *timeout < 0 {
+		err = fmt.Errorf("-timeout must not be negative, but was %s", *timeout)
+	}
+	if err == nil && *workers < 1 {
+		err = fmt.Errorf("-parallel must be at least 1, but was %d", *workers)
+	}
 	if err != nil {
-		fmt.Printf("Failed to execute task. Reason: %s", err.Error())
+		fmt.Fprintf(os.Stderr, "%s\n\n", err.Error())
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var answers answerBook
+	if *verify {
+		answers, err = loadAnswers(*answersFilePath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+	}
+
+	var inputOverride *inputSource
+	if *inputFilePath == stdinPath {
+		source, err := newStdinSource()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+		inputOverride = &source
+	} else if *inputFilePath != "" {
+		source := newFileSource(*inputFilePath)
+		inputOverride = &source
+	}
+
+	runs := 1
+	if *benchmarkRuns > 0 {
+		runs = *benchmarkRuns
+	}
+
+	var tasks []func() result
+	for _, solution := range registry.All() {
+		if !selection.includes(solution.Year, solution.Day, solution.Part) {
+			continue
+		}
+
+		solution := solution
+		tasks = append(tasks, func() result {
+			var result result
+			switch {
+			case inputOverride != nil:
+				result = execute(solution, *inputOverride, runs, *timeout)
+			case *useSample:
+				samplePath, found := solution.SampleInputPath()
+				if !found {
+					return newFailedResult(solution, "", errors.New("no sample input found"))
+				}
+				result = execute(solution, newFileSource(samplePath), runs, *timeout)
+			default:
+				result = execute(solution, newFileSource(solution.DefaultInputPath()), runs, *timeout)
+			}
+
+			if answers != nil {
+				result.verdict, result.expectedAnswer = answers.verify(result)
+			}
+			return result
+		})
+	}
+
+	if len(tasks) == 0 {
+		fmt.Fprintln(os.Stderr, "no solutions match the selection")
+		os.Exit(1)
+	}
+
+	var results []result
+	runInOrder(tasks, *workers, func(result result) bool {
+		if *outputFormat == textOutput {
+			printResult(result, *showTiming)
+		}
+		results = append(results, result)
+		return result.succeeded() || !*failFast
+	})
+
+	if *outputFormat == textOutput {
+		printSummary(os.Stdout, results, *showTiming || *benchmarkRuns > 0)
+	} else if err := writeResults(os.Stdout, results, *outputFormat); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write results. %s\n", err.Error())
+		os.Exit(1)
+	}
+	if anyFailed(results) {
+		os.Exit(1)
+	}
+}
+
+// A result is the outcome of executing a single part of a puzzle.
+type result struct {
+	solution      registry.Solution
+	inputFilePath string
+	answer        answer.Answer
+	err           error
+	// verdict and expectedAnswer are only populated when answers are being verified.
+	verdict        verdict
+	expectedAnswer string
+	// measurement describes the first run of the part, and benchmark is only populated if the part was run repeatedly.
+	measurement measurement
+	benchmark   *benchmark
+}
+
+func newFailedResult(solution registry.Solution, filePath string, err error) result {
+	return result{
+		solution:      solution,
+		inputFilePath: filePath,
+		err:           err,
+	}
+}
+
+// succeeded reports whether the part produced an answer, and that the answer was not known to be wrong.
+func (result result) succeeded() bool {
+	return result.err == nil && result.verdict != fail
+}
+
+// timedOut reports whether the part was abandoned because it ran for longer than the timeout.
+func (result result) timedOut() bool {
+	return errors.Is(result.err, context.DeadlineExceeded)
+}
+
+// duration returns the median duration of the part if it was benchmarked, or the duration of its only run if not.
+func (result result) duration() time.Duration {
+	if result.benchmark != nil {
+		return result.benchmark.median
+	}
+	return result.measurement.duration
+}
+
+// execute runs the solution the given number of times, stopping early if it fails. The answer from the first run is
+// reported.
+func execute(solution registry.Solution, source inputSource, runs int, timeout time.Duration) result {
+	result := result{
+		solution:      solution,
+		inputFilePath: source.path,
+	}
+
+	durations := make([]time.Duration, 0, runs)
+	for i := 0; i < runs; i++ {
+		var partAnswer answer.Answer
+		var err error
+		measurement := measure(func() {
+			partAnswer, err = solve(solution, source, timeout)
+		})
+		if i == 0 {
+			result.answer, result.measurement = partAnswer, measurement
+		}
+		if err != nil {
+			result.err = err
+			return result
+		}
+		durations = append(durations, measurement.duration)
+	}
+
+	if runs > 1 {
+		benchmark := newBenchmark(durations)
+		result.benchmark = &benchmark
+	}
+	return result
+}
+
+// solve runs the solution, converting any panic into an error so that one broken part cannot stop the others from
+// running. If the timeout is non-zero, the solution is abandoned once it has run for that long, even if it does not
+// notice that its context has been cancelled.
+func solve(solution registry.Solution, source inputSource, timeout time.Duration) (answer.Answer, error) {
+	input, err := source.open()
+	if err != nil {
+		return answer.Answer{}, err
+	}
+	defer input.Close()
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	type outcome struct {
+		answer answer.Answer
+		err    error
+	}
+	outcomes := make(chan outcome, 1)
+	go func() {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				outcomes <- outcome{err: fmt.Errorf("panicked: %v", recovered)}
+			}
+		}()
+		partAnswer, err := solution.Solve(ctx, input)
+		outcomes <- outcome{answer: partAnswer, err: err}
+	}()
+
+	var result outcome
+	select {
+	case result = <-outcomes:
+	case <-ctx.Done():
+		result.err = ctx.Err()
+	}
+	if errors.Is(result.err, context.DeadlineExceeded) {
+		result.err = fmt.Errorf("timed out after %s. %w", timeout, result.err)
+	}
+	return result.answer, result.err
+}
+
+func printResult(result result, showTiming bool) {
+	fmt.Printf("%d day %d, part %d:\n", result.solution.Year, result.solution.Day, result.solution.Part)
+	switch {
+	case result.timedOut():
+		fmt.Printf("Timed out. Reason: %s\n", result.err.Error())
+	case result.err != nil:
+		fmt.Printf("Failed to execute task. Reason: %s\n", result.err.Error())
+	default:
+		fmt.Println(result.answer.String())
+	}
+	if result.verdict == fail && result.err == nil {
+		fmt.Printf("Expected: %s\n", result.expectedAnswer)
+	}
+	if showTiming {
+		fmt.Printf("Took %s\n", result.measurement)
+	}
+	if result.benchmark != nil {
+		fmt.Printf("Benchmark over %s\n", result.benchmark)
 	}
-	fmt.Printf("%s\n\n", answer)
+	fmt.Println()
 }
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"advent-of-code/answer"
+	"advent-of-code/registry"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSolveReportsTimeoutDistinctly(t *testing.T) {
+	solution := registry.Solution{Year: 2021, Day: 1, Part: 1, Solve: func(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+		<-ctx.Done()
+		return answer.Answer{}, ctx.Err()
+	}}
+
+	_, err := solve(solution, inputSource{content: []byte("1\n")}, 10*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected the part to time out")
+	}
+	if !(result{err: err}).timedOut() {
+		t.Errorf("expected the error to count as a timeout but got %v", err)
+	}
+	if !strings.Contains(err.Error(), "timed out after 10ms") {
+		t.Errorf("expected the error to give the timeout but got %q", err.Error())
+	}
+}
+
+func TestSolveTurnsPanicsIntoErrors(t *testing.T) {
+	solution := registry.Solution{Year: 2021, Day: 1, Part: 1, Solve: func(context.Context, io.Reader) (answer.Answer, error) {
+		panic("index out of range")
+	}}
+
+	_, err := solve(solution, inputSource{content: []byte("1\n")}, time.Second)
+	if err == nil {
+		t.Fatal("expected the panic to be reported as an error")
+	}
+	if (result{err: err}).timedOut() {
+		t.Errorf("expected a panic not to count as a timeout but got %v", err)
+	}
+	if !strings.Contains(err.Error(), "panicked: index out of range") {
+		t.Errorf("expected the error to describe the panic but got %q", err.Error())
+	}
+}
@@ -0,0 +1,80 @@
+// Package answer describes the answer to one part of a puzzle. Solutions return the raw answer along with what it
+// represents, leaving it to the caller to decide how the answer should be presented.
+package answer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Answer is the answer to one part of a puzzle.
+type Answer struct {
+	// Value is the answer itself, usually a number. It is nil if the puzzle has no answer for the given input.
+	Value interface{}
+	// Description optionally describes what the value represents, such as "Winning score".
+	Description string
+	// Diagnostics holds any additional information worked out on the way to the answer.
+	Diagnostics []Diagnostic
+}
+
+// A Diagnostic is a named piece of additional information about an answer.
+type Diagnostic struct {
+	Name  string
+	Value interface{}
+}
+
+// New creates an answer with the given value and description.
+func New(value interface{}, description string) Answer {
+	return Answer{
+		Value:       value,
+		Description: description,
+	}
+}
+
+// None creates an answer for a puzzle that has no answer for the given input, describing why.
+func None(description string) Answer {
+	return Answer{Description: description}
+}
+
+// With returns a copy of the answer with the given diagnostic added.
+func (answer Answer) With(name string, value interface{}) Answer {
+	diagnostics := make([]Diagnostic, len(answer.Diagnostics), len(answer.Diagnostics)+1)
+	copy(diagnostics, answer.Diagnostics)
+	answer.Diagnostics = append(diagnostics, Diagnostic{Name: name, Value: value})
+	return answer
+}
+
+// HasValue reports whether the puzzle had an answer for the given input.
+func (answer Answer) HasValue() bool {
+	return answer.Value != nil
+}
+
+// ValueString returns the value on its own, formatted as it would be submitted. It is empty if there is no value.
+func (answer Answer) ValueString() string {
+	if !answer.HasValue() {
+		return ""
+	}
+	return fmt.Sprint(answer.Value)
+}
+
+// String describes the answer in full, such as "Fuel required: 37 (best position: 2)".
+func (answer Answer) String() string {
+	var builder strings.Builder
+	switch {
+	case !answer.HasValue():
+		builder.WriteString(answer.Description)
+	case answer.Description == "":
+		builder.WriteString(answer.ValueString())
+	default:
+		fmt.Fprintf(&builder, "%s: %s", answer.Description, answer.ValueString())
+	}
+
+	if len(answer.Diagnostics) > 0 {
+		diagnostics := make([]string, len(answer.Diagnostics))
+		for index, diagnostic := range answer.Diagnostics {
+			diagnostics[index] = fmt.Sprintf("%s: %v", diagnostic.Name, diagnostic.Value)
+		}
+		fmt.Fprintf(&builder, " (%s)", strings.Join(diagnostics, ", "))
+	}
+	return builder.String()
+}
@@ -0,0 +1,167 @@
+// Package aoc talks to the Advent of Code website on behalf of the runner. Every request is authenticated with the
+// user's session token and requests are spaced out so that the site is never hammered.
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the address of the Advent of Code website.
+	DefaultBaseURL = "https://adventofcode.com"
+	// DefaultMinInterval is the minimum time left between any two requests made by a client.
+	DefaultMinInterval = 5 * time.Second
+	// SessionEnvVar is the environment variable that may hold the session token.
+	SessionEnvVar = "AOC_SESSION"
+
+	userAgent = "advent-of-code runner"
+)
+
+// Client makes authenticated requests to the Advent of Code website, or anything that behaves like it. A Client is
+// safe for concurrent use, although requests are always made one at a time.
+type Client struct {
+	BaseURL     string
+	Session     string
+	MinInterval time.Duration
+	HTTPClient  *http.Client
+	// LastRequestFile, if set, records the time of the latest request so that MinInterval is also kept between
+	// separate runs of the program.
+	LastRequestFile string
+
+	mutex       sync.Mutex
+	lastRequest time.Time
+}
+
+// NewClient creates a client for the site at the given base URL, using the default minimum request interval.
+func NewClient(baseURL, session string) *Client {
+	return &Client{
+		BaseURL:     strings.TrimRight(baseURL, "/"),
+		Session:     session,
+		MinInterval: DefaultMinInterval,
+		HTTPClient:  http.DefaultClient,
+	}
+}
+
+// LoadSession returns the session token from the AOC_SESSION environment variable or, if that is not set, from the
+// given config file.
+func LoadSession(configFilePath string) (string, error) {
+	if session := strings.TrimSpace(os.Getenv(SessionEnvVar)); session != "" {
+		return session, nil
+	}
+	content, err := os.ReadFile(configFilePath)
+	if err != nil {
+		return "", fmt.Errorf("no session token in $%s and could not read config file %q. %w", SessionEnvVar, configFilePath, err)
+	}
+	session := strings.TrimSpace(string(content))
+	if session == "" {
+		return "", fmt.Errorf("config file %q does not contain a session token", configFilePath)
+	}
+	return session, nil
+}
+
+// DefaultConfigFilePath returns the path of the file that the session token is read from by default.
+func DefaultConfigFilePath() string {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return filepath.Join(".advent-of-code", "session")
+	}
+	return filepath.Join(configDir, "advent-of-code", "session")
+}
+
+// DefaultLastRequestFilePath returns the path of the file that records the time of the latest request, which is
+// kept next to the session token.
+func DefaultLastRequestFilePath() string {
+	return filepath.Join(filepath.Dir(DefaultConfigFilePath()), "last-request")
+}
+
+// do sends the request once at least MinInterval has passed since the previous request, whether that was made by
+// this client or, through LastRequestFile, by an earlier run.
+func (client *Client) do(ctx context.Context, request *http.Request) (*http.Response, error) {
+	if client.Session == "" {
+		return nil, errors.New("no session token configured")
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	if client.LastRequestFile != "" {
+		recorded, err := readLastRequest(client.LastRequestFile)
+		if err != nil {
+			return nil, err
+		}
+		if recorded.After(client.lastRequest) {
+			client.lastRequest = recorded
+		}
+	}
+	if wait := time.Until(client.lastRequest.Add(client.MinInterval)); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+	client.lastRequest = time.Now()
+
+	request = request.WithContext(ctx)
+	request.AddCookie(&http.Cookie{Name: "session", Value: client.Session})
+	request.Header.Set("User-Agent", userAgent)
+	response, err := client.HTTPClient.Do(request)
+
+	// The time is recorded once the request has gone, so that later runs never count from before it was sent.
+	if client.LastRequestFile != "" {
+		if writeErr := writeLastRequest(client.LastRequestFile, time.Now()); writeErr != nil {
+			if err == nil {
+				response.Body.Close()
+			}
+			return nil, writeErr
+		}
+	}
+	return response, err
+}
+
+// readLastRequest returns the time recorded in the file, or the zero time if there is no file yet.
+func readLastRequest(filePath string) (time.Time, error) {
+	content, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not read last request time %q. %w", filePath, err)
+	}
+	lastRequest, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(string(content)))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not parse last request time %q. %w", filePath, err)
+	}
+	return lastRequest, nil
+}
+
+func writeLastRequest(filePath string, lastRequest time.Time) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return fmt.Errorf("could not create directory for last request time %q. %w", filePath, err)
+	}
+	if err := os.WriteFile(filePath, []byte(lastRequest.Format(time.RFC3339Nano)+"\n"), 0644); err != nil {
+		return fmt.Errorf("could not record last request time %q. %w", filePath, err)
+	}
+	return nil
+}
+
+// newUnexpectedStatusError describes a response that had an unexpected status, including the start of its body.
+func newUnexpectedStatusError(response *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(response.Body, 200))
+	return fmt.Errorf("unexpected response %q: %s", response.Status, strings.TrimSpace(string(body)))
+}
+
+func (client *Client) dayURL(year, day int) string {
+	return fmt.Sprintf("%s/%d/day/%d", client.BaseURL, year, day)
+}
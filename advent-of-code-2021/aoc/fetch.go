@@ -0,0 +1,62 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// FetchInput makes sure the input for the given day is cached at cachePath, downloading it if it is not. A cached
+// input is never downloaded again. downloaded reports whether a request was made.
+func (client *Client) FetchInput(ctx context.Context, year, day int, cachePath string) (downloaded bool, err error) {
+	if _, err := os.Stat(cachePath); err == nil {
+		return false, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return false, fmt.Errorf("could not check for cached input %q. %w", cachePath, err)
+	}
+
+	request, err := http.NewRequest(http.MethodGet, client.dayURL(year, day)+"/input", nil)
+	if err != nil {
+		return false, err
+	}
+	response, err := client.do(ctx, request)
+	if err != nil {
+		return false, fmt.Errorf("could not fetch input for %d day %d. %w", year, day, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("could not fetch input for %d day %d. %w", year, day, newUnexpectedStatusError(response))
+	}
+
+	if err := writeAtomically(cachePath, response.Body); err != nil {
+		return false, fmt.Errorf("could not cache input for %d day %d. %w", year, day, err)
+	}
+	return true, nil
+}
+
+// writeAtomically writes the content to a temporary file that is only moved into place once it is complete, so that
+// an interrupted download is never mistaken for a cached input.
+func writeAtomically(filePath string, content io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(filePath), filepath.Base(filePath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := io.Copy(file, content); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), filePath)
+}
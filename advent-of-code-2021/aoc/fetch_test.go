@@ -0,0 +1,197 @@
+package aoc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSite stands in for the Advent of Code website, recording the time of every request it receives.
+type fakeSite struct {
+	mutex    sync.Mutex
+	requests []time.Time
+}
+
+func (site *fakeSite) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	site.mutex.Lock()
+	site.requests = append(site.requests, time.Now())
+	site.mutex.Unlock()
+
+	cookie, err := request.Cookie("session")
+	if err != nil || cookie.Value != "secret" {
+		http.Error(writer, "Puzzle inputs differ by user. Please log in to get your puzzle input.", http.StatusBadRequest)
+		return
+	}
+	switch request.URL.Path {
+	case "/2021/day/1/input":
+		writer.Write([]byte("199\n200\n208\n"))
+	case "/2021/day/2/input":
+		writer.Write([]byte("forward 5\n"))
+	default:
+		http.NotFound(writer, request)
+	}
+}
+
+func (site *fakeSite) requestTimes() []time.Time {
+	site.mutex.Lock()
+	defer site.mutex.Unlock()
+	return append([]time.Time(nil), site.requests...)
+}
+
+func newTestClient(t *testing.T, session string) (*Client, *fakeSite) {
+	site := &fakeSite{}
+	server := httptest.NewServer(site)
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, session)
+	client.MinInterval = 0
+	client.HTTPClient = server.Client()
+	return client, site
+}
+
+func TestFetchInputDownloadsAndCaches(t *testing.T) {
+	client, site := newTestClient(t, "secret")
+	cachePath := filepath.Join(t.TempDir(), "day1", "measurements.csv")
+
+	downloaded, err := client.FetchInput(context.Background(), 2021, 1, cachePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !downloaded {
+		t.Error("expected the input to be downloaded")
+	}
+	content, err := os.ReadFile(cachePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "199\n200\n208\n" {
+		t.Errorf("unexpected cached input %q", content)
+	}
+
+	downloaded, err = client.FetchInput(context.Background(), 2021, 1, cachePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if downloaded {
+		t.Error("expected the cached input to be used")
+	}
+	if requests := len(site.requestTimes()); requests != 1 {
+		t.Errorf("expected 1 request but got %d", requests)
+	}
+}
+
+func TestFetchInputDoesNotCacheFailures(t *testing.T) {
+	client, _ := newTestClient(t, "wrong")
+	cachePath := filepath.Join(t.TempDir(), "input.txt")
+
+	if _, err := client.FetchInput(context.Background(), 2021, 1, cachePath); err == nil {
+		t.Fatal("expected an error for a rejected session")
+	}
+	if _, err := os.Stat(cachePath); !os.IsNotExist(err) {
+		t.Errorf("expected nothing to be cached, but stat returned %v", err)
+	}
+	if matches, _ := filepath.Glob(filepath.Join(filepath.Dir(cachePath), "*")); len(matches) != 0 {
+		t.Errorf("expected no files to be left behind, but found %v", matches)
+	}
+}
+
+// sendTimeRecorder records the time that the client sends each request, which is what MinInterval spaces out. The
+// time the server receives a request also includes connecting, which only the first request has to do.
+type sendTimeRecorder struct {
+	transport http.RoundTripper
+	mutex     sync.Mutex
+	sent      []time.Time
+}
+
+func (recorder *sendTimeRecorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	recorder.mutex.Lock()
+	recorder.sent = append(recorder.sent, time.Now())
+	recorder.mutex.Unlock()
+	return recorder.transport.RoundTrip(request)
+}
+
+func TestFetchInputRespectsMinInterval(t *testing.T) {
+	client, _ := newTestClient(t, "secret")
+	client.MinInterval = 100 * time.Millisecond
+	recorder := &sendTimeRecorder{transport: client.HTTPClient.Transport}
+	client.HTTPClient = &http.Client{Transport: recorder}
+	directory := t.TempDir()
+
+	for day := 1; day <= 2; day++ {
+		cachePath := filepath.Join(directory, fmt.Sprintf("day%d.txt", day))
+		if _, err := client.FetchInput(context.Background(), 2021, day, cachePath); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	requests := recorder.sent
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests but got %d", len(requests))
+	}
+	if gap := requests[1].Sub(requests[0]); gap < client.MinInterval {
+		t.Errorf("requests were only %s apart", gap)
+	}
+}
+
+func TestFetchInputRespectsMinIntervalAcrossClients(t *testing.T) {
+	lastRequestFile := filepath.Join(t.TempDir(), "last-request")
+	directory := t.TempDir()
+
+	var clients []*Client
+	var recorders []*sendTimeRecorder
+	for day := 1; day <= 2; day++ {
+		client, _ := newTestClient(t, "secret")
+		client.MinInterval = 100 * time.Millisecond
+		client.LastRequestFile = lastRequestFile
+		recorder := &sendTimeRecorder{transport: client.HTTPClient.Transport}
+		client.HTTPClient = &http.Client{Transport: recorder}
+		clients, recorders = append(clients, client), append(recorders, recorder)
+
+		cachePath := filepath.Join(directory, fmt.Sprintf("day%d.txt", day))
+		if _, err := client.FetchInput(context.Background(), 2021, day, cachePath); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(recorders[0].sent) != 1 || len(recorders[1].sent) != 1 {
+		t.Fatalf("expected each client to make 1 request")
+	}
+	if gap := recorders[1].sent[0].Sub(recorders[0].sent[0]); gap < clients[1].MinInterval {
+		t.Errorf("requests from separate clients were only %s apart", gap)
+	}
+}
+
+func TestFetchInputGivesUpWhenCancelledWhileWaiting(t *testing.T) {
+	client, _ := newTestClient(t, "secret")
+	client.MinInterval = time.Hour
+	client.lastRequest = time.Now()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.FetchInput(ctx, 2021, 1, filepath.Join(t.TempDir(), "input.txt")); err == nil {
+		t.Fatal("expected the fetch to be abandoned")
+	}
+}
+
+func TestLoadSessionPrefersEnvironment(t *testing.T) {
+	configFilePath := filepath.Join(t.TempDir(), "session")
+	if err := os.WriteFile(configFilePath, []byte("from-file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(SessionEnvVar, "")
+	if session, err := LoadSession(configFilePath); err != nil || session != "from-file" {
+		t.Errorf("expected session from file but got %q, %v", session, err)
+	}
+
+	t.Setenv(SessionEnvVar, "from-env")
+	if session, err := LoadSession(configFilePath); err != nil || session != "from-env" {
+		t.Errorf("expected session from environment but got %q, %v", session, err)
+	}
+}
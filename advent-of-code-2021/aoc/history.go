@@ -0,0 +1,113 @@
+package aoc
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+)
+
+// A Submission is an answer that was submitted, along with the outcome.
+type Submission struct {
+	Year        int       `json:"year"`
+	Day         int       `json:"day"`
+	Part        int       `json:"part"`
+	Answer      string    `json:"answer"`
+	Outcome     Outcome   `json:"outcome"`
+	SubmittedAt time.Time `json:"submitted_at"`
+	// WaitUntil is when the website will next accept an answer, or nil if it did not ask for a wait.
+	WaitUntil *time.Time `json:"wait_until,omitempty"`
+}
+
+// History is the record of every answer submitted, which is stored as one JSON object per line so that new
+// submissions can simply be appended.
+type History struct {
+	filePath    string
+	submissions []Submission
+}
+
+// LoadHistory reads the history from the given file. A missing file is treated as an empty history.
+func LoadHistory(filePath string) (*History, error) {
+	history := &History{filePath: filePath}
+
+	file, err := os.Open(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return history, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not open submission history %q. %w", filePath, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		var submission Submission
+		if err := json.Unmarshal(scanner.Bytes(), &submission); err != nil {
+			return nil, fmt.Errorf("could not parse line %d of submission history %q. %w", lineNumber, filePath, err)
+		}
+		history.submissions = append(history.submissions, submission)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read submission history %q. %w", filePath, err)
+	}
+	return history, nil
+}
+
+// Record adds the submission to the history file.
+func (history *History) Record(submission Submission) error {
+	line, err := json.Marshal(submission)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(history.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("could not open submission history %q. %w", history.filePath, err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return fmt.Errorf("could not write to submission history %q. %w", history.filePath, err)
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	history.submissions = append(history.submissions, submission)
+	return nil
+}
+
+// Check returns an error if the history shows that submitting the answer would be pointless: because the part has
+// already been solved, because the answer is already known to be wrong, or because the website asked for a wait that
+// has not yet passed.
+func (history *History) Check(year, day, part int, answer string, now time.Time) error {
+	for _, submission := range history.submissions {
+		if submission.Year != year || submission.Day != day || submission.Part != part {
+			continue
+		}
+		comparison, comparable := compareNumbers(answer, submission.Answer)
+		switch {
+		case submission.Outcome == Correct:
+			return fmt.Errorf("already solved with answer %q", submission.Answer)
+		case submission.Outcome.IsWrong() && submission.Answer == answer:
+			return fmt.Errorf("%q has already been submitted and was %s", answer, submission.Outcome)
+		case submission.Outcome == TooHigh && comparable && comparison >= 0:
+			return fmt.Errorf("%q cannot be right because %q was too high", answer, submission.Answer)
+		case submission.Outcome == TooLow && comparable && comparison <= 0:
+			return fmt.Errorf("%q cannot be right because %q was too low", answer, submission.Answer)
+		case submission.WaitUntil != nil && now.Before(*submission.WaitUntil):
+			return fmt.Errorf("the website asked for a wait until %s", submission.WaitUntil.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// compareNumbers compares two answers numerically, returning -1, 0 or 1. comparable is false if either answer is not
+// an integer.
+func compareNumbers(a, b string) (comparison int, comparable bool) {
+	first, firstOk := new(big.Int).SetString(a, 10)
+	second, secondOk := new(big.Int).SetString(b, 10)
+	if !firstOk || !secondOk {
+		return 0, false
+	}
+	return first.Cmp(second), true
+}
@@ -0,0 +1,74 @@
+package aoc
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHistoryRefusesPointlessSubmissions(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "submissions.jsonl")
+	now := time.Date(2021, 12, 7, 6, 0, 0, 0, time.UTC)
+	waitUntil := now.Add(time.Minute)
+
+	history, err := LoadHistory(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	submissions := []Submission{
+		{Year: 2021, Day: 7, Part: 1, Answer: "40", Outcome: TooHigh, SubmittedAt: now},
+		{Year: 2021, Day: 7, Part: 1, Answer: "30", Outcome: TooLow, SubmittedAt: now},
+		{Year: 2021, Day: 7, Part: 1, Answer: "35", Outcome: Incorrect, SubmittedAt: now},
+		{Year: 2021, Day: 7, Part: 2, Answer: "168", Outcome: Correct, SubmittedAt: now},
+		{Year: 2021, Day: 8, Part: 1, Answer: "1", Outcome: Wait, SubmittedAt: now, WaitUntil: &waitUntil},
+	}
+	for _, submission := range submissions {
+		if err := history.Record(submission); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count := strings.Count(string(content), "wait_until"); count != 1 {
+		t.Errorf("expected only the submission that was asked to wait to record a wait, but found %d", count)
+	}
+
+	// Reload to make sure that the history survives being written to disk
+	history, err = LoadHistory(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		day, part       int
+		answer          string
+		at              time.Time
+		shouldBeAllowed bool
+	}{
+		{name: "known wrong", day: 7, part: 1, answer: "35", at: now},
+		{name: "above a too high answer", day: 7, part: 1, answer: "41", at: now},
+		{name: "below a too low answer", day: 7, part: 1, answer: "29", at: now},
+		{name: "between the bounds", day: 7, part: 1, answer: "37", at: now, shouldBeAllowed: true},
+		{name: "already solved", day: 7, part: 2, answer: "169", at: now},
+		{name: "during a wait", day: 8, part: 1, answer: "2", at: now.Add(30 * time.Second)},
+		{name: "after a wait", day: 8, part: 1, answer: "2", at: now.Add(2 * time.Minute), shouldBeAllowed: true},
+		{name: "another puzzle", day: 9, part: 1, answer: "35", at: now, shouldBeAllowed: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := history.Check(2021, test.day, test.part, test.answer, test.at)
+			if test.shouldBeAllowed && err != nil {
+				t.Errorf("expected submission to be allowed but got %v", err)
+			}
+			if !test.shouldBeAllowed && err == nil {
+				t.Error("expected submission to be refused")
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package aoc
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Outcome is the website's verdict on a submitted answer.
+type Outcome string
+
+const (
+	Correct Outcome = "correct"
+	TooHigh Outcome = "too high"
+	TooLow  Outcome = "too low"
+	// Incorrect answers are wrong, but the website did not say whether they were too high or too low.
+	Incorrect Outcome = "incorrect"
+	// Wait means that the answer was not checked because another answer was submitted too recently.
+	Wait Outcome = "wait"
+	// AlreadySolved means that the answer was not checked because the part has already been solved.
+	AlreadySolved Outcome = "already solved"
+	Unknown       Outcome = "unknown"
+)
+
+// IsWrong reports whether the outcome shows that the submitted answer was checked and found to be wrong.
+func (outcome Outcome) IsWrong() bool {
+	return outcome == TooHigh || outcome == TooLow || outcome == Incorrect
+}
+
+// Response is the parsed response to a submitted answer.
+type Response struct {
+	Outcome Outcome
+	// Wait is how long the website asked for before another answer is submitted, if it said.
+	Wait time.Duration
+	// Message is the text of the response, without any markup.
+	Message string
+}
+
+var (
+	articlePattern     = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagPattern         = regexp.MustCompile(`<[^>]*>`)
+	whitespacePattern  = regexp.MustCompile(`\s+`)
+	leftToWaitPattern  = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	waitMinutesPattern = regexp.MustCompile(`wait (one|\d+) minutes? before trying again`)
+)
+
+// ParseResponse works out the outcome of a submission from the page returned by the website.
+func ParseResponse(page string) Response {
+	message := page
+	if match := articlePattern.FindStringSubmatch(page); match != nil {
+		message = match[1]
+	}
+	message = html.UnescapeString(tagPattern.ReplaceAllString(message, ""))
+	message = strings.TrimSpace(whitespacePattern.ReplaceAllString(message, " "))
+
+	response := Response{Outcome: Unknown, Message: message}
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		response.Outcome = Correct
+	case strings.Contains(message, "your answer is too high"):
+		response.Outcome = TooHigh
+	case strings.Contains(message, "your answer is too low"):
+		response.Outcome = TooLow
+	case strings.Contains(message, "That's not the right answer"):
+		response.Outcome = Incorrect
+	case strings.Contains(message, "You gave an answer too recently"):
+		response.Outcome = Wait
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		response.Outcome = AlreadySolved
+	}
+
+	if match := leftToWaitPattern.FindStringSubmatch(message); match != nil {
+		minutes, _ := strconv.Atoi(match[1])
+		seconds, _ := strconv.Atoi(match[2])
+		response.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if match := waitMinutesPattern.FindStringSubmatch(message); match != nil {
+		minutes := 1
+		if match[1] != "one" {
+			minutes, _ = strconv.Atoi(match[1])
+		}
+		response.Wait = time.Duration(minutes) * time.Minute
+	}
+	return response
+}
+
+// Submit posts the answer to the given part of a puzzle and parses the website's response.
+func (client *Client) Submit(ctx context.Context, year, day, part int, answer string) (Response, error) {
+	form := url.Values{
+		"level":  {strconv.Itoa(part)},
+		"answer": {answer},
+	}
+	request, err := http.NewRequest(http.MethodPost, client.dayURL(year, day)+"/answer", strings.NewReader(form.Encode()))
+	if err != nil {
+		return Response{}, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := client.do(ctx, request)
+	if err != nil {
+		return Response{}, fmt.Errorf("could not submit answer for %d day %d part %d. %w", year, day, part, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return Response{}, fmt.Errorf(
+			"could not submit answer for %d day %d part %d. %w", year, day, part, newUnexpectedStatusError(response),
+		)
+	}
+	page, err := io.ReadAll(response.Body)
+	if err != nil {
+		return Response{}, fmt.Errorf("could not read response to answer for %d day %d part %d. %w", year, day, part, err)
+	}
+	return ParseResponse(string(page)), nil
+}
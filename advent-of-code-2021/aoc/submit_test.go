@@ -0,0 +1,95 @@
+package aoc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		page    string
+		outcome Outcome
+		wait    time.Duration
+	}{
+		{
+			name:    "correct",
+			page:    `<html><main><article><p>That's the right answer!  You are <em>one gold star</em> closer.</p></article></main></html>`,
+			outcome: Correct,
+		},
+		{
+			name:    "too high",
+			page:    `<article><p>That's not the right answer; your answer is too high.  Please wait one minute before trying again.</p></article>`,
+			outcome: TooHigh,
+			wait:    time.Minute,
+		},
+		{
+			name:    "too low",
+			page:    `<article><p>That's not the right answer; your answer is too low.  Please wait 5 minutes before trying again.</p></article>`,
+			outcome: TooLow,
+			wait:    5 * time.Minute,
+		},
+		{
+			name:    "incorrect",
+			page:    `<article><p>That's not the right answer.  If you're stuck, make sure you're using the full input data.</p></article>`,
+			outcome: Incorrect,
+		},
+		{
+			name:    "wait",
+			page:    `<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 23s left to wait.</p></article>`,
+			outcome: Wait,
+			wait:    83 * time.Second,
+		},
+		{
+			name:    "already solved",
+			page:    `<article><p>You don't seem to be solving the right level.  Did you already complete it?</p></article>`,
+			outcome: AlreadySolved,
+		},
+		{
+			name:    "unrecognised",
+			page:    `<html>Something else entirely</html>`,
+			outcome: Unknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := ParseResponse(test.page)
+			if response.Outcome != test.outcome {
+				t.Errorf("expected outcome %q but got %q from message %q", test.outcome, response.Outcome, response.Message)
+			}
+			if response.Wait != test.wait {
+				t.Errorf("expected wait of %s but got %s", test.wait, response.Wait)
+			}
+		})
+	}
+}
+
+func TestSubmitPostsAnswer(t *testing.T) {
+	var level, submittedAnswer string
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost || request.URL.Path != "/2021/day/7/answer" {
+			http.NotFound(writer, request)
+			return
+		}
+		level, submittedAnswer = request.FormValue("level"), request.FormValue("answer")
+		writer.Write([]byte(`<article><p>That's not the right answer; your answer is too low.</p></article>`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "secret")
+	client.MinInterval = 0
+	response, err := client.Submit(context.Background(), 2021, 7, 2, "168")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if level != "2" || submittedAnswer != "168" {
+		t.Errorf("expected level 2 and answer 168 but got level %q and answer %q", level, submittedAnswer)
+	}
+	if response.Outcome != TooLow {
+		t.Errorf("expected outcome %q but got %q", TooLow, response.Outcome)
+	}
+}
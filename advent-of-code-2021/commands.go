@@ -0,0 +1,255 @@
+package main
+
+import (
+	"advent-of-code/aoc"
+	"advent-of-code/registry"
+	"advent-of-code/scaffold"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// commands holds the subcommands that can be given as the first argument to the runner instead of running solutions.
+// Each returns the status that the process should exit with.
+var commands = map[string]func(args []string) int{
+	"fetch":     fetchCommand,
+	"new-day":   newDayCommand,
+	"sonar":     sonarCommand,
+	"submarine": submarineCommand,
+	"submit":    submitCommand,
+}
+
+func printUsage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	output := flag.CommandLine.Output()
+	fmt.Fprintf(output, "Usage:\n  %s [flags]\n  %s <command> [flags]\n\n", os.Args[0], os.Args[0])
+	fmt.Fprintf(output, "Commands: %s\n\nFlags:\n", strings.Join(names, ", "))
+	flag.PrintDefaults()
+}
+
+func fetchCommand(args []string) int {
+	flags := flag.NewFlagSet("fetch", flag.ExitOnError)
+	year := flags.Int("year", registry.LatestYear(), "the year to fetch inputs for")
+	daySpec := flags.String("day", "", "the day(s) to fetch inputs for, e.g. 9, 3-7 or 1,4-6")
+	newClient := addClientFlags(flags)
+	flags.Parse(args)
+
+	days, err := parseDays(*daySpec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid day selection %q. %s\n", *daySpec, err.Error())
+		return 2
+	}
+
+	client, err := newClient()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+
+	status := 0
+	for _, day := range sortedDays(days) {
+		solution, found := registry.Lookup(*year, day, 1)
+		if !found {
+			fmt.Fprintf(os.Stderr, "%d day %d has no registered solution, so has nowhere to cache its input\n", *year, day)
+			status = 1
+			continue
+		}
+
+		cachePath := solution.DefaultInputPath()
+		downloaded, err := client.FetchInput(context.Background(), *year, day, cachePath)
+		switch {
+		case err != nil:
+			fmt.Fprintln(os.Stderr, err.Error())
+			status = 1
+		case downloaded:
+			fmt.Printf("Fetched %s\n", cachePath)
+		default:
+			fmt.Printf("Already cached %s\n", cachePath)
+		}
+	}
+	return status
+}
+
+func submitCommand(args []string) int {
+	flags := flag.NewFlagSet("submit", flag.ExitOnError)
+	year := flags.Int("year", registry.LatestYear(), "the year of the puzzle")
+	day := flags.Int("day", 0, "the day of the puzzle")
+	part := flags.Int("part", 0, "the part of the puzzle (1 or 2)")
+	submittedAnswer := flags.String("answer", "", "the answer to submit. The part is run on its default input if omitted")
+	historyFilePath := flags.String("history", "submissions.jsonl", "the file that records every submission")
+	newClient := addClientFlags(flags)
+	flags.Parse(args)
+
+	solution, found := registry.Lookup(*year, *day, *part)
+	if !found {
+		fmt.Fprintf(os.Stderr, "%d day %d part %d has no registered solution\n", *year, *day, *part)
+		return 2
+	}
+
+	if *submittedAnswer == "" {
+		partAnswer, err := solve(solution, newFileSource(solution.DefaultInputPath()), 0)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to execute task. %s\n", err.Error())
+			return 1
+		}
+		if !partAnswer.HasValue() {
+			fmt.Fprintf(os.Stderr, "there is no answer to submit: %s\n", partAnswer.String())
+			return 1
+		}
+		fmt.Println(partAnswer.String())
+		*submittedAnswer = partAnswer.ValueString()
+	}
+
+	history, err := aoc.LoadHistory(*historyFilePath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+	if err := history.Check(*year, *day, *part, *submittedAnswer, time.Now()); err != nil {
+		fmt.Fprintf(os.Stderr, "not submitting %q: %s\n", *submittedAnswer, err.Error())
+		return 1
+	}
+
+	client, err := newClient()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+	submittedAt := time.Now()
+	response, err := client.Submit(context.Background(), *year, *day, *part, *submittedAnswer)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+
+	submission := aoc.Submission{
+		Year:        *year,
+		Day:         *day,
+		Part:        *part,
+		Answer:      *submittedAnswer,
+		Outcome:     response.Outcome,
+		SubmittedAt: submittedAt,
+	}
+	if response.Wait > 0 {
+		waitUntil := submittedAt.Add(response.Wait)
+		submission.WaitUntil = &waitUntil
+	}
+	if err := history.Record(submission); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	fmt.Printf("%s: %s\n", *submittedAnswer, response.Outcome)
+	if response.Wait > 0 {
+		fmt.Printf("Wait %s before submitting again\n", response.Wait)
+	}
+	if response.Outcome == aoc.Unknown {
+		fmt.Println(response.Message)
+	}
+	if response.Outcome != aoc.Correct {
+		return 1
+	}
+	return 0
+}
+
+func newDayCommand(args []string) int {
+	flags := flag.NewFlagSet("new-day", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s new-day [flags] <day>\n\nFlags:\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	year := flags.Int("year", registry.LatestYear(), "the year of the puzzle")
+	shape := flags.String("shape", "lines", "the shape of the input: "+strings.Join(scaffold.Shapes, ", "))
+	inputFile := flags.String("input", "input.txt", "the name of the file that will hold the puzzle input")
+	templatesDir := flags.String("templates", "", "a directory of templates to use instead of the built-in ones")
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+	day, err := parseDay(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 2
+	}
+
+	module, err := scaffold.ReadModulePath(".")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "new-day must be run from the module root. %s\n", err.Error())
+		return 1
+	}
+	newDay := scaffold.Day{Module: module, Year: *year, Day: day, Shape: *shape, InputFile: *inputFile}
+
+	templates := scaffold.DefaultTemplates
+	if *templatesDir != "" {
+		templates = os.DirFS(*templatesDir)
+	}
+
+	created, err := scaffold.Generate(templates, ".", newDay)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "could not generate day %d. %s\n", day, err.Error())
+		return 1
+	}
+	for _, filePath := range created {
+		fmt.Printf("Created %s\n", filePath)
+	}
+
+	createdYear, err := scaffold.GenerateYear(".", newDay)
+	if err == nil && createdYear {
+		fmt.Printf("Created %s\n", newDay.YearFile())
+		err = scaffold.Register("years.go", newDay.YearImportPath())
+	}
+	if err == nil {
+		err = scaffold.Register(newDay.YearFile(), newDay.ImportPath())
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "could not register day %d with the runner. %s\n", day, err.Error())
+		return 1
+	}
+	fmt.Printf("Registered with the runner in %s\n", newDay.YearFile())
+	return 0
+}
+
+// addClientFlags adds the flags that configure how the website is contacted, returning a function that creates a
+// client from them once the flags have been parsed.
+func addClientFlags(flags *flag.FlagSet) func() (*aoc.Client, error) {
+	baseURL := flags.String("base-url", aoc.DefaultBaseURL, "the address of the Advent of Code website")
+	sessionFilePath := flags.String(
+		"session-file", aoc.DefaultConfigFilePath(),
+		"the file holding the session token, used if $"+aoc.SessionEnvVar+" is not set",
+	)
+	minInterval := flags.Duration("min-interval", aoc.DefaultMinInterval, "the minimum time between requests")
+	lastRequestFilePath := flags.String(
+		"last-request-file", aoc.DefaultLastRequestFilePath(),
+		"the file recording when the latest request was made, so that -min-interval holds across runs",
+	)
+
+	return func() (*aoc.Client, error) {
+		session, err := aoc.LoadSession(*sessionFilePath)
+		if err != nil {
+			return nil, err
+		}
+		client := aoc.NewClient(*baseURL, session)
+		client.MinInterval = *minInterval
+		client.LastRequestFile = *lastRequestFilePath
+		return client, nil
+	}
+}
+
+func sortedDays(days map[int]bool) []int {
+	sorted := make([]int, 0, len(days))
+	for day := range days {
+		sorted = append(sorted, day)
+	}
+	sort.Ints(sorted)
+	return sorted
+}
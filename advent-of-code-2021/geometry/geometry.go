@@ -0,0 +1,100 @@
+// Package geometry provides integer points, vectors, line segments and bounding boxes for puzzles set on a plane.
+package geometry
+
+import "fmt"
+
+// A Point is a location on the plane.
+type Point struct {
+	X, Y int
+}
+
+// Add returns the point reached by moving from this point by the vector.
+func (point Point) Add(vector Vector) Point {
+	return Point{X: point.X + vector.X, Y: point.Y + vector.Y}
+}
+
+// Sub returns the vector that leads from the other point to this one.
+func (point Point) Sub(other Point) Vector {
+	return Vector{X: point.X - other.X, Y: point.Y - other.Y}
+}
+
+func (point Point) String() string {
+	return fmt.Sprintf("(%d,%d)", point.X, point.Y)
+}
+
+// A Vector is a displacement across the plane.
+type Vector struct {
+	X, Y int
+}
+
+// Add returns the sum of the two vectors.
+func (vector Vector) Add(other Vector) Vector {
+	return Vector{X: vector.X + other.X, Y: vector.Y + other.Y}
+}
+
+// Scale returns the vector multiplied by the factor.
+func (vector Vector) Scale(factor int) Vector {
+	return Vector{X: vector.X * factor, Y: vector.Y * factor}
+}
+
+// Sign returns the vector with each component replaced by -1, 0 or 1 according to its sign. For horizontal, vertical
+// and 45° vectors, this is the single step that repeatedly moves along the vector.
+func (vector Vector) Sign() Vector {
+	return Vector{X: Sign(vector.X), Y: Sign(vector.Y)}
+}
+
+func (vector Vector) String() string {
+	return fmt.Sprintf("<%d,%d>", vector.X, vector.Y)
+}
+
+// ManhattanDistance returns the distance between the points when moving only horizontally and vertically.
+func ManhattanDistance(from, to Point) int {
+	return Abs(to.X-from.X) + Abs(to.Y-from.Y)
+}
+
+// ChebyshevDistance returns the distance between the points when diagonal moves are allowed, and cost the same as
+// horizontal and vertical ones.
+func ChebyshevDistance(from, to Point) int {
+	return Max(Abs(to.X-from.X), Abs(to.Y-from.Y))
+}
+
+// Abs returns the absolute value of the value.
+func Abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+// Sign returns -1 for negative values, 1 for positive values and 0 for 0.
+func Sign(value int) int {
+	if value < 0 {
+		return -1
+	}
+	if value > 0 {
+		return 1
+	}
+	return 0
+}
+
+// Max returns the largest of the values, which must not be empty.
+func Max(first int, others ...int) int {
+	result := first
+	for _, value := range others {
+		if value > result {
+			result = value
+		}
+	}
+	return result
+}
+
+// Min returns the smallest of the values, which must not be empty.
+func Min(first int, others ...int) int {
+	result := first
+	for _, value := range others {
+		if value < result {
+			result = value
+		}
+	}
+	return result
+}
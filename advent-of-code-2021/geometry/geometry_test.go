@@ -0,0 +1,154 @@
+package geometry
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDistances(t *testing.T) {
+	from, to := Point{X: 1, Y: -2}, Point{X: -3, Y: 5}
+	if distance := ManhattanDistance(from, to); distance != 11 {
+		t.Errorf("expected a Manhattan distance of 11 but got %d", distance)
+	}
+	if distance := ChebyshevDistance(from, to); distance != 7 {
+		t.Errorf("expected a Chebyshev distance of 7 but got %d", distance)
+	}
+}
+
+func TestPointsAndVectors(t *testing.T) {
+	from, to := Point{X: 1, Y: 1}, Point{X: 4, Y: -1}
+	vector := to.Sub(from)
+	if vector != (Vector{X: 3, Y: -2}) {
+		t.Errorf("unexpected vector %s", vector)
+	}
+	if from.Add(vector) != to {
+		t.Errorf("expected %s + %s to be %s", from, vector, to)
+	}
+	if vector.Scale(2).Add(vector) != (Vector{X: 9, Y: -6}) {
+		t.Errorf("unexpected scaled vector %s", vector.Scale(2).Add(vector))
+	}
+	if vector.Sign() != (Vector{X: 1, Y: -1}) {
+		t.Errorf("unexpected sign %s", vector.Sign())
+	}
+}
+
+func TestSegmentPoints(t *testing.T) {
+	tests := []struct {
+		name     string
+		segment  Segment
+		expected []Point
+	}{
+		{
+			name:     "horizontal",
+			segment:  Segment{Start: Point{X: 3, Y: 4}, End: Point{X: 1, Y: 4}},
+			expected: []Point{{X: 3, Y: 4}, {X: 2, Y: 4}, {X: 1, Y: 4}},
+		},
+		{
+			name:     "vertical",
+			segment:  Segment{Start: Point{X: 1, Y: 1}, End: Point{X: 1, Y: 3}},
+			expected: []Point{{X: 1, Y: 1}, {X: 1, Y: 2}, {X: 1, Y: 3}},
+		},
+		{
+			name:     "diagonal",
+			segment:  Segment{Start: Point{X: 9, Y: 7}, End: Point{X: 7, Y: 9}},
+			expected: []Point{{X: 9, Y: 7}, {X: 8, Y: 8}, {X: 7, Y: 9}},
+		},
+		{
+			name:     "single point",
+			segment:  Segment{Start: Point{X: 2, Y: 2}, End: Point{X: 2, Y: 2}},
+			expected: []Point{{X: 2, Y: 2}},
+		},
+		{
+			name:     "arbitrary slope",
+			segment:  Segment{Start: Point{X: 0, Y: 0}, End: Point{X: 5, Y: 2}},
+			expected: []Point{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 1}, {X: 3, Y: 1}, {X: 4, Y: 2}, {X: 5, Y: 2}},
+		},
+		{
+			name:     "steep arbitrary slope",
+			segment:  Segment{Start: Point{X: 0, Y: 0}, End: Point{X: -1, Y: -3}},
+			expected: []Point{{X: 0, Y: 0}, {X: 0, Y: -1}, {X: -1, Y: -2}, {X: -1, Y: -3}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if points := test.segment.Points(); !reflect.DeepEqual(points, test.expected) {
+				t.Errorf("expected %v but got %v", test.expected, points)
+			}
+		})
+	}
+}
+
+func TestSegmentIntersects(t *testing.T) {
+	tests := []struct {
+		name        string
+		first       Segment
+		second      Segment
+		intersected bool
+	}{
+		{
+			name:        "crossing",
+			first:       Segment{Start: Point{X: 0, Y: 0}, End: Point{X: 4, Y: 4}},
+			second:      Segment{Start: Point{X: 0, Y: 4}, End: Point{X: 4, Y: 0}},
+			intersected: true,
+		},
+		{
+			name:        "touching at an end",
+			first:       Segment{Start: Point{X: 0, Y: 0}, End: Point{X: 2, Y: 0}},
+			second:      Segment{Start: Point{X: 2, Y: 0}, End: Point{X: 2, Y: 5}},
+			intersected: true,
+		},
+		{
+			name:        "overlapping in a line",
+			first:       Segment{Start: Point{X: 0, Y: 0}, End: Point{X: 3, Y: 0}},
+			second:      Segment{Start: Point{X: 2, Y: 0}, End: Point{X: 6, Y: 0}},
+			intersected: true,
+		},
+		{
+			name:        "separate in a line",
+			first:       Segment{Start: Point{X: 0, Y: 0}, End: Point{X: 1, Y: 0}},
+			second:      Segment{Start: Point{X: 2, Y: 0}, End: Point{X: 6, Y: 0}},
+			intersected: false,
+		},
+		{
+			name:        "parallel",
+			first:       Segment{Start: Point{X: 0, Y: 0}, End: Point{X: 3, Y: 3}},
+			second:      Segment{Start: Point{X: 1, Y: 0}, End: Point{X: 4, Y: 3}},
+			intersected: false,
+		},
+		{
+			name:        "would cross if extended",
+			first:       Segment{Start: Point{X: 0, Y: 0}, End: Point{X: 1, Y: 1}},
+			second:      Segment{Start: Point{X: 0, Y: 4}, End: Point{X: 4, Y: 0}},
+			intersected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.first.Intersects(test.second) != test.intersected || test.second.Intersects(test.first) != test.intersected {
+				t.Errorf("expected intersection to be %v", test.intersected)
+			}
+		})
+	}
+}
+
+func TestBoundingBox(t *testing.T) {
+	box := NewBoundingBox(Point{X: 3, Y: -1}, Point{X: 0, Y: 2}, Point{X: 1, Y: 1})
+	if box != (BoundingBox{Min: Point{X: 0, Y: -1}, Max: Point{X: 3, Y: 2}}) {
+		t.Fatalf("unexpected box %v", box)
+	}
+	if box.Width() != 4 || box.Height() != 4 {
+		t.Errorf("unexpected size %dx%d", box.Width(), box.Height())
+	}
+	if !box.Contains(Point{X: 3, Y: 2}) || box.Contains(Point{X: 4, Y: 0}) {
+		t.Error("unexpected containment of the edges")
+	}
+
+	other := BoundingBox{Min: Point{X: 2, Y: 2}, Max: Point{X: 5, Y: 5}}
+	intersection, intersects := box.Intersection(other)
+	if !intersects || intersection != (BoundingBox{Min: Point{X: 2, Y: 2}, Max: Point{X: 3, Y: 2}}) {
+		t.Errorf("unexpected intersection %v, %v", intersection, intersects)
+	}
+	if box.Intersects(BoundingBox{Min: Point{X: 4, Y: 0}, Max: Point{X: 5, Y: 0}}) {
+		t.Error("expected boxes side by side not to intersect")
+	}
+}
@@ -0,0 +1,151 @@
+package geometry
+
+import "fmt"
+
+// A Segment is the straight line between two points, including both of them.
+type Segment struct {
+	Start, End Point
+}
+
+// IsHorizontal reports whether every point on the segment has the same Y coordinate.
+func (segment Segment) IsHorizontal() bool {
+	return segment.Start.Y == segment.End.Y
+}
+
+// IsVertical reports whether every point on the segment has the same X coordinate.
+func (segment Segment) IsVertical() bool {
+	return segment.Start.X == segment.End.X
+}
+
+// IsDiagonal reports whether the segment is at exactly 45° to the axes.
+func (segment Segment) IsDiagonal() bool {
+	direction := segment.End.Sub(segment.Start)
+	return direction.X != 0 && Abs(direction.X) == Abs(direction.Y)
+}
+
+// Points returns every point with integer coordinates on the segment, from the start to the end. Horizontal, vertical
+// and 45° segments pass exactly through each point; the points of any other segment are the nearest to the true line,
+// as chosen by Bresenham's algorithm.
+func (segment Segment) Points() []Point {
+	direction := segment.End.Sub(segment.Start)
+	length := ChebyshevDistance(segment.Start, segment.End)
+	step := direction.Sign()
+	points := make([]Point, length+1)
+
+	if segment.IsHorizontal() || segment.IsVertical() || segment.IsDiagonal() {
+		points[0] = segment.Start
+		for i := 1; i <= length; i++ {
+			points[i] = points[i-1].Add(step)
+		}
+		return points
+	}
+
+	deltaX, deltaY := Abs(direction.X), -Abs(direction.Y)
+	errorTerm := deltaX + deltaY
+	current := segment.Start
+	for i := range points {
+		points[i] = current
+		doubledError := 2 * errorTerm
+		if doubledError >= deltaY {
+			errorTerm += deltaY
+			current.X += step.X
+		}
+		if doubledError <= deltaX {
+			errorTerm += deltaX
+			current.Y += step.Y
+		}
+	}
+	return points
+}
+
+// BoundingBox returns the smallest box that contains the whole segment.
+func (segment Segment) BoundingBox() BoundingBox {
+	return NewBoundingBox(segment.Start, segment.End)
+}
+
+// Contains reports whether the point lies exactly on the segment.
+func (segment Segment) Contains(point Point) bool {
+	return orientation(segment.Start, segment.End, point) == 0 && segment.BoundingBox().Contains(point)
+}
+
+// Intersects reports whether the two segments share at least one point, which need not have integer coordinates.
+func (segment Segment) Intersects(other Segment) bool {
+	first := orientation(segment.Start, segment.End, other.Start)
+	second := orientation(segment.Start, segment.End, other.End)
+	third := orientation(other.Start, other.End, segment.Start)
+	fourth := orientation(other.Start, other.End, segment.End)
+
+	if first != second && third != fourth {
+		return true
+	}
+	return segment.Contains(other.Start) || segment.Contains(other.End) ||
+		other.Contains(segment.Start) || other.Contains(segment.End)
+}
+
+// orientation returns 1 if the points turn anticlockwise, -1 if they turn clockwise, and 0 if they are in a line.
+func orientation(first, second, third Point) int {
+	return Sign(crossProduct(second.Sub(first), third.Sub(first)))
+}
+
+func crossProduct(first, second Vector) int {
+	return first.X*second.Y - first.Y*second.X
+}
+
+func (segment Segment) String() string {
+	return fmt.Sprintf("%s -> %s", segment.Start, segment.End)
+}
+
+// A BoundingBox is the rectangle of points from Min to Max inclusive, with sides parallel to the axes.
+type BoundingBox struct {
+	Min, Max Point
+}
+
+// NewBoundingBox returns the smallest box containing every point. At least one point must be given.
+func NewBoundingBox(first Point, others ...Point) BoundingBox {
+	box := BoundingBox{Min: first, Max: first}
+	for _, point := range others {
+		box = box.Include(point)
+	}
+	return box
+}
+
+// Include returns the smallest box containing both this box and the point.
+func (box BoundingBox) Include(point Point) BoundingBox {
+	return BoundingBox{
+		Min: Point{X: Min(box.Min.X, point.X), Y: Min(box.Min.Y, point.Y)},
+		Max: Point{X: Max(box.Max.X, point.X), Y: Max(box.Max.Y, point.Y)},
+	}
+}
+
+// Width returns the number of distinct X coordinates within the box.
+func (box BoundingBox) Width() int {
+	return box.Max.X - box.Min.X + 1
+}
+
+// Height returns the number of distinct Y coordinates within the box.
+func (box BoundingBox) Height() int {
+	return box.Max.Y - box.Min.Y + 1
+}
+
+// Contains reports whether the point is inside the box or on its edge.
+func (box BoundingBox) Contains(point Point) bool {
+	return point.X >= box.Min.X && point.X <= box.Max.X && point.Y >= box.Min.Y && point.Y <= box.Max.Y
+}
+
+// Intersection returns the box covered by both boxes, reporting false if they do not overlap.
+func (box BoundingBox) Intersection(other BoundingBox) (BoundingBox, bool) {
+	intersection := BoundingBox{
+		Min: Point{X: Max(box.Min.X, other.Min.X), Y: Max(box.Min.Y, other.Min.Y)},
+		Max: Point{X: Min(box.Max.X, other.Max.X), Y: Min(box.Max.Y, other.Max.Y)},
+	}
+	if intersection.Min.X > intersection.Max.X || intersection.Min.Y > intersection.Max.Y {
+		return BoundingBox{}, false
+	}
+	return intersection, true
+}
+
+// Intersects reports whether the boxes share at least one point.
+func (box BoundingBox) Intersects(other BoundingBox) bool {
+	_, intersects := box.Intersection(other)
+	return intersects
+}
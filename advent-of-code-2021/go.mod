@@ -1,3 +1,3 @@
-module advent-of-code-2021
+module advent-of-code
 
-go 1.17
+go 1.18
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"advent-of-code/registry"
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "regenerate the golden files from the current answers")
+
+// TestSamples runs every registered part against the sample input in its day's directory, comparing the rendered
+// answer to the golden file in testdata. Parts without an answer or a golden file yet, such as newly scaffolded days,
+// are skipped. Run with -update to regenerate the golden files after an intended change.
+func TestSamples(t *testing.T) {
+	for _, solution := range registry.All() {
+		solution := solution
+		samplePath, found := solution.SampleInputPath()
+		if !found {
+			continue
+		}
+
+		t.Run(fmt.Sprintf("%d/day%d/part%d", solution.Year, solution.Day, solution.Part), func(t *testing.T) {
+			partAnswer, err := registry.SolveFile(context.Background(), samplePath, solution.Solve)
+			if err != nil {
+				t.Fatalf("failed to solve %s: %v", samplePath, err)
+			}
+			actual := []byte(partAnswer.String() + "\n")
+
+			goldenPath := goldenFilePath(solution, samplePath)
+			if *update {
+				if err := os.MkdirAll(filepath.Dir(goldenPath), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(goldenPath, actual, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			expected, err := os.ReadFile(goldenPath)
+			if errors.Is(err, os.ErrNotExist) && !partAnswer.HasValue() {
+				t.Skipf("no answer yet and no golden file at %s", goldenPath)
+			}
+			if err != nil {
+				t.Fatalf("could not read golden file. Run with -update to create it. %v", err)
+			}
+			if !bytes.Equal(actual, expected) {
+				t.Errorf("answer for %s does not match %s\nexpected: %s\nactual:   %s", samplePath, goldenPath, expected, actual)
+			}
+		})
+	}
+}
+
+func goldenFilePath(solution registry.Solution, samplePath string) string {
+	return filepath.Join(
+		"testdata", "golden", solution.Directory(),
+		fmt.Sprintf("part%d.%s.golden", solution.Part, filepath.Base(samplePath)),
+	)
+}
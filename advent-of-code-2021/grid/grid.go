@@ -0,0 +1,217 @@
+// Package grid provides a rectangular two-dimensional grid of values that can be addressed either by (x, y)
+// coordinates or by the index of the value when the grid is laid out row by row.
+package grid
+
+import (
+	"advent-of-code/geometry"
+	"fmt"
+	"strings"
+)
+
+// A Point is a location in a grid. X increases to the right and Y increases downwards, starting from 0 in the top left.
+type Point = geometry.Point
+
+// Steps to the neighbouring points in each direction.
+var (
+	North     = geometry.Vector{X: 0, Y: -1}
+	NorthEast = geometry.Vector{X: 1, Y: -1}
+	East      = geometry.Vector{X: 1, Y: 0}
+	SouthEast = geometry.Vector{X: 1, Y: 1}
+	South     = geometry.Vector{X: 0, Y: 1}
+	SouthWest = geometry.Vector{X: -1, Y: 1}
+	West      = geometry.Vector{X: -1, Y: 0}
+	NorthWest = geometry.Vector{X: -1, Y: -1}
+)
+
+// Orthogonal lists the directions of the four neighbours that share an edge with a point.
+var Orthogonal = []geometry.Vector{North, East, South, West}
+
+// AllDirections lists the directions of the eight neighbours that share an edge or a corner with a point.
+var AllDirections = []geometry.Vector{North, NorthEast, East, SouthEast, South, SouthWest, West, NorthWest}
+
+// A Grid holds width × height values. By default, points beyond the edges of the grid do not exist; if the grid wraps,
+// they instead refer to the point on the opposite edge, as though the grid were repeated in every direction.
+type Grid[T any] struct {
+	values []T
+	width  int
+	height int
+	wrap   bool
+}
+
+// New creates a grid of the given size, with every value set to the zero value.
+func New[T any](width, height int) *Grid[T] {
+	return &Grid[T]{values: make([]T, width*height), width: width, height: height}
+}
+
+// FromValues creates a grid of the given width from values laid out row by row. The grid uses the slice directly
+// rather than copying it.
+func FromValues[T any](values []T, width int) (*Grid[T], error) {
+	if width < 1 {
+		return nil, fmt.Errorf("width must be at least 1, but was %d", width)
+	}
+	if len(values)%width != 0 {
+		return nil, fmt.Errorf("%d values do not fill rows of width %d", len(values), width)
+	}
+	return &Grid[T]{values: values, width: width, height: len(values) / width}, nil
+}
+
+// SetWrap sets whether points beyond the edges of the grid wrap around to the opposite edge.
+func (grid *Grid[T]) SetWrap(wrap bool) {
+	grid.wrap = wrap
+}
+
+func (grid *Grid[T]) Width() int {
+	return grid.width
+}
+
+func (grid *Grid[T]) Height() int {
+	return grid.height
+}
+
+// Len returns the number of values in the grid.
+func (grid *Grid[T]) Len() int {
+	return len(grid.values)
+}
+
+// Values returns every value in the grid, row by row. Changes to the slice change the grid.
+func (grid *Grid[T]) Values() []T {
+	return grid.values
+}
+
+// Index returns the index of the point when the grid is laid out row by row, reporting false if the point does not
+// exist.
+func (grid *Grid[T]) Index(point Point) (int, bool) {
+	point, exists := grid.resolve(point)
+	if !exists {
+		return 0, false
+	}
+	return point.Y*grid.width + point.X, true
+}
+
+// Point returns the point at the given index. The index must be within the grid.
+func (grid *Grid[T]) Point(index int) Point {
+	return Point{X: index % grid.width, Y: index / grid.width}
+}
+
+// Contains reports whether the point exists, which is always the case for a grid that wraps.
+func (grid *Grid[T]) Contains(point Point) bool {
+	_, exists := grid.resolve(point)
+	return exists
+}
+
+func (grid *Grid[T]) resolve(point Point) (Point, bool) {
+	if grid.wrap {
+		return Point{X: modulo(point.X, grid.width), Y: modulo(point.Y, grid.height)}, grid.width > 0 && grid.height > 0
+	}
+	return point, point.X >= 0 && point.X < grid.width && point.Y >= 0 && point.Y < grid.height
+}
+
+func modulo(value, divisor int) int {
+	if divisor == 0 {
+		return 0
+	}
+	return ((value % divisor) + divisor) % divisor
+}
+
+// Get returns the value at the point, reporting false if the point does not exist.
+func (grid *Grid[T]) Get(point Point) (value T, exists bool) {
+	index, exists := grid.Index(point)
+	if !exists {
+		return
+	}
+	return grid.values[index], true
+}
+
+// Set changes the value at the point, reporting false if the point does not exist.
+func (grid *Grid[T]) Set(point Point, value T) bool {
+	index, exists := grid.Index(point)
+	if exists {
+		grid.values[index] = value
+	}
+	return exists
+}
+
+// At returns the value at the given index. The index must be within the grid.
+func (grid *Grid[T]) At(index int) T {
+	return grid.values[index]
+}
+
+// SetAt changes the value at the given index. The index must be within the grid.
+func (grid *Grid[T]) SetAt(index int, value T) {
+	grid.values[index] = value
+}
+
+// Neighbours returns the points next to the given point in each of the directions, skipping any that do not exist.
+func (grid *Grid[T]) Neighbours(point Point, directions []geometry.Vector) []Point {
+	neighbours := make([]Point, 0, len(directions))
+	for _, direction := range directions {
+		if neighbour, exists := grid.resolve(point.Add(direction)); exists {
+			neighbours = append(neighbours, neighbour)
+		}
+	}
+	return neighbours
+}
+
+// NeighbourIndexes returns the indexes of the values next to the value at the given index in each of the directions,
+// skipping any that do not exist.
+func (grid *Grid[T]) NeighbourIndexes(index int, directions []geometry.Vector) []int {
+	point := grid.Point(index)
+	indexes := make([]int, 0, len(directions))
+	for _, direction := range directions {
+		if neighbour, exists := grid.Index(point.Add(direction)); exists {
+			indexes = append(indexes, neighbour)
+		}
+	}
+	return indexes
+}
+
+// Row returns the values in row y, from left to right. Changes to the slice change the grid.
+func (grid *Grid[T]) Row(y int) []T {
+	return grid.values[y*grid.width : (y+1)*grid.width]
+}
+
+// Column returns a copy of the values in column x, from top to bottom.
+func (grid *Grid[T]) Column(x int) []T {
+	column := make([]T, grid.height)
+	for y := range column {
+		column[y] = grid.values[y*grid.width+x]
+	}
+	return column
+}
+
+// Rows returns every row of the grid, from top to bottom.
+func (grid *Grid[T]) Rows() [][]T {
+	rows := make([][]T, grid.height)
+	for y := range rows {
+		rows[y] = grid.Row(y)
+	}
+	return rows
+}
+
+// Columns returns a copy of every column of the grid, from left to right.
+func (grid *Grid[T]) Columns() [][]T {
+	columns := make([][]T, grid.width)
+	for x := range columns {
+		columns[x] = grid.Column(x)
+	}
+	return columns
+}
+
+// Render draws the grid as text with one line per row, using format to draw each value.
+func (grid *Grid[T]) Render(format func(value T) string) string {
+	var builder strings.Builder
+	for y := 0; y < grid.height; y++ {
+		for _, value := range grid.Row(y) {
+			builder.WriteString(format(value))
+		}
+		builder.WriteByte('\n')
+	}
+	return builder.String()
+}
+
+// String draws the grid with the default format of each value, which suits grids of digits.
+func (grid *Grid[T]) String() string {
+	return grid.Render(func(value T) string {
+		return fmt.Sprint(value)
+	})
+}
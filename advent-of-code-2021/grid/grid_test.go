@@ -0,0 +1,134 @@
+package grid
+
+import (
+	"advent-of-code/geometry"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func newTestGrid(t *testing.T) *Grid[int] {
+	t.Helper()
+	grid, err := FromValues([]int{
+		1, 2, 3,
+		4, 5, 6,
+	}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return grid
+}
+
+func TestFromValuesRejectsIncompleteRows(t *testing.T) {
+	if _, err := FromValues([]int{1, 2, 3, 4}, 3); err == nil {
+		t.Error("expected an error when the values do not fill the last row")
+	}
+	if _, err := FromValues([]int{1}, 0); err == nil {
+		t.Error("expected an error for a width of 0")
+	}
+}
+
+func TestPointsAndIndexesAgree(t *testing.T) {
+	grid := newTestGrid(t)
+	if grid.Width() != 3 || grid.Height() != 2 || grid.Len() != 6 {
+		t.Fatalf("unexpected size %dx%d", grid.Width(), grid.Height())
+	}
+	for index := 0; index < grid.Len(); index++ {
+		point := grid.Point(index)
+		if roundTrip, exists := grid.Index(point); !exists || roundTrip != index {
+			t.Errorf("index %d became point %s, which became index %d", index, point, roundTrip)
+		}
+		if value, _ := grid.Get(point); value != grid.At(index) {
+			t.Errorf("point %s has value %d but index %d has value %d", point, value, index, grid.At(index))
+		}
+	}
+}
+
+func TestPointsBeyondTheEdgesDoNotExist(t *testing.T) {
+	grid := newTestGrid(t)
+	for _, point := range []Point{{X: -1, Y: 0}, {X: 3, Y: 0}, {X: 0, Y: -1}, {X: 0, Y: 2}} {
+		if grid.Contains(point) {
+			t.Errorf("expected %s not to exist", point)
+		}
+		if _, exists := grid.Get(point); exists {
+			t.Errorf("expected no value at %s", point)
+		}
+		if grid.Set(point, 9) {
+			t.Errorf("expected setting %s to fail", point)
+		}
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	grid := newTestGrid(t)
+	tests := []struct {
+		name       string
+		point      Point
+		directions []geometry.Vector
+		expected   []int
+	}{
+		{name: "orthogonal from corner", point: Point{X: 0, Y: 0}, directions: Orthogonal, expected: []int{1, 3}},
+		{name: "orthogonal from edge", point: Point{X: 1, Y: 0}, directions: Orthogonal, expected: []int{0, 2, 4}},
+		{name: "all from corner", point: Point{X: 2, Y: 1}, directions: AllDirections, expected: []int{1, 2, 4}},
+		{name: "all from edge", point: Point{X: 1, Y: 1}, directions: AllDirections, expected: []int{0, 1, 2, 3, 5}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			index, _ := grid.Index(test.point)
+			indexes := grid.NeighbourIndexes(index, test.directions)
+			sort.Ints(indexes)
+			if !reflect.DeepEqual(indexes, test.expected) {
+				t.Errorf("expected %v but got %v", test.expected, indexes)
+			}
+			if points := grid.Neighbours(test.point, test.directions); len(points) != len(test.expected) {
+				t.Errorf("expected %d neighbouring points but got %v", len(test.expected), points)
+			}
+		})
+	}
+}
+
+func TestWrappingGridWrapsAroundEdges(t *testing.T) {
+	grid := newTestGrid(t)
+	grid.SetWrap(true)
+
+	if value, exists := grid.Get(Point{X: -1, Y: -1}); !exists || value != 6 {
+		t.Errorf("expected (-1,-1) to wrap to 6 but got %d, %v", value, exists)
+	}
+	index, _ := grid.Index(Point{X: 0, Y: 0})
+	indexes := grid.NeighbourIndexes(index, Orthogonal)
+	if !reflect.DeepEqual(indexes, []int{3, 1, 3, 2}) {
+		t.Errorf("unexpected wrapped neighbours %v", indexes)
+	}
+}
+
+func TestRowsAndColumns(t *testing.T) {
+	grid := newTestGrid(t)
+	if !reflect.DeepEqual(grid.Rows(), [][]int{{1, 2, 3}, {4, 5, 6}}) {
+		t.Errorf("unexpected rows %v", grid.Rows())
+	}
+	if !reflect.DeepEqual(grid.Columns(), [][]int{{1, 4}, {2, 5}, {3, 6}}) {
+		t.Errorf("unexpected columns %v", grid.Columns())
+	}
+
+	grid.Row(1)[0] = 7
+	if value, _ := grid.Get(Point{X: 0, Y: 1}); value != 7 {
+		t.Errorf("expected changing the row to change the grid, but (0,1) is %d", value)
+	}
+}
+
+func TestRender(t *testing.T) {
+	grid := newTestGrid(t)
+	if grid.String() != "123\n456\n" {
+		t.Errorf("unexpected rendering:\n%s", grid)
+	}
+
+	rendered := grid.Render(func(value int) string {
+		if value%2 == 0 {
+			return "#"
+		}
+		return "."
+	})
+	if rendered != ".#.\n#.#\n" {
+		t.Errorf("unexpected rendering:\n%s", rendered)
+	}
+}
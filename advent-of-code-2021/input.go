@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+)
+
+// stdinPath is the input path that reads the puzzle input from standard input.
+const stdinPath = "-"
+
+// An inputSource supplies the input for a part, which is opened afresh for every run of the part.
+type inputSource struct {
+	path string
+	// content holds the input if it has been read up front, as it must be for standard input because it can only be
+	// read once.
+	content []byte
+}
+
+func newFileSource(path string) inputSource {
+	return inputSource{path: path}
+}
+
+// newStdinSource reads all of standard input so that it can be given to any number of parts.
+func newStdinSource() (inputSource, error) {
+	content, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return inputSource{}, fmt.Errorf("could not read input from stdin. %w", err)
+	}
+	return inputSource{path: stdinPath, content: content}, nil
+}
+
+func (source inputSource) open() (io.ReadCloser, error) {
+	if source.content != nil {
+		return io.NopCloser(bytes.NewReader(source.content)), nil
+	}
+	file, err := os.Open(source.path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open input file %q. %w", source.path, err)
+	}
+	return file, nil
+}
+
+// openInput opens the file at the path for reading once, or standard input if the path is stdinPath.
+func openInput(path string) (io.ReadCloser, error) {
+	if path == stdinPath {
+		return io.NopCloser(os.Stdin), nil
+	}
+	return newFileSource(path).open()
+}
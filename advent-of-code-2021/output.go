@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+const (
+	textOutput = "text"
+	jsonOutput = "json"
+	csvOutput  = "csv"
+)
+
+// stdoutPath is the output path that writes to standard output.
+const stdoutPath = "-"
+
+// A record is the machine-readable representation of a result.
+type record struct {
+	Year        int                    `json:"year"`
+	Day         int                    `json:"day"`
+	Part        int                    `json:"part"`
+	Input       string                 `json:"input"`
+	Answer      interface{}            `json:"answer"`
+	Message     string                 `json:"message"`
+	Diagnostics map[string]interface{} `json:"diagnostics,omitempty"`
+	Error       string                 `json:"error,omitempty"`
+	TimedOut    bool                   `json:"timed_out,omitempty"`
+	Verdict     string                 `json:"verdict,omitempty"`
+	DurationNs  int64                  `json:"duration_ns"`
+}
+
+var csvHeader = []string{"year", "day", "part", "input", "answer", "message", "error", "timed_out", "verdict", "duration_ns"}
+
+func newRecord(result result) record {
+	record := record{
+		Year:       result.solution.Year,
+		Day:        result.solution.Day,
+		Part:       result.solution.Part,
+		Input:      result.inputFilePath,
+		Answer:     result.answer.Value,
+		Message:    result.answer.String(),
+		Verdict:    string(result.verdict),
+		DurationNs: result.duration().Nanoseconds(),
+	}
+	if len(result.answer.Diagnostics) > 0 {
+		record.Diagnostics = make(map[string]interface{})
+		for _, diagnostic := range result.answer.Diagnostics {
+			record.Diagnostics[diagnostic.Name] = diagnostic.Value
+		}
+	}
+	if result.err != nil {
+		record.Error = result.err.Error()
+		record.TimedOut = result.timedOut()
+	}
+	return record
+}
+
+func (record record) toCSV() []string {
+	return []string{
+		strconv.Itoa(record.Year),
+		strconv.Itoa(record.Day),
+		strconv.Itoa(record.Part),
+		record.Input,
+		formatAnswer(record.Answer),
+		record.Message,
+		record.Error,
+		strconv.FormatBool(record.TimedOut),
+		record.Verdict,
+		strconv.FormatInt(record.DurationNs, 10),
+	}
+}
+
+func formatAnswer(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// writeResults writes every result to the writer in the given machine-readable format.
+func writeResults(writer io.Writer, results []result, format string) error {
+	records := make([]record, len(results))
+	for index, result := range results {
+		records[index] = newRecord(result)
+	}
+
+	switch format {
+	case jsonOutput:
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+	case csvOutput:
+		csvWriter := csv.NewWriter(writer)
+		if err := csvWriter.Write(csvHeader); err != nil {
+			return err
+		}
+		for _, record := range records {
+			if err := csvWriter.Write(record.toCSV()); err != nil {
+				return err
+			}
+		}
+		csvWriter.Flush()
+		return csvWriter.Error()
+	default:
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+}
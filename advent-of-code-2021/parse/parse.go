@@ -0,0 +1,238 @@
+// Package parse reads the shapes of input that puzzles commonly use, such as a line of comma-separated integers or a
+// grid of digits. Every error describes where in the input the problem was found.
+package parse
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// A Position is a location within the input. Lines and columns are both numbered from 1, and columns count bytes.
+type Position struct {
+	Line   int
+	Column int
+}
+
+// Offset returns the position that is the given number of bytes further along the same line.
+func (position Position) Offset(bytes int) Position {
+	return Position{Line: position.Line, Column: position.Column + bytes}
+}
+
+func (position Position) String() string {
+	return fmt.Sprintf("line %d, column %d", position.Line, position.Column)
+}
+
+// An Error describes a part of the input that could not be parsed.
+type Error struct {
+	Position
+	Err error
+}
+
+func (err *Error) Error() string {
+	return fmt.Sprintf("%s: %s", err.Position, err.Err.Error())
+}
+
+func (err *Error) Unwrap() error {
+	return err.Err
+}
+
+func errorAt(position Position, format string, args ...interface{}) error {
+	return &Error{Position: position, Err: fmt.Errorf(format, args...)}
+}
+
+// Int converts the text found at the given position to an integer.
+func Int(text string, position Position) (int, error) {
+	value, err := strconv.Atoi(text)
+	if err != nil {
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) {
+			err = numErr.Err
+		}
+		return 0, errorAt(position, "%q is not an integer. %w", text, err)
+	}
+	return value, nil
+}
+
+// Ints converts the text found at the given position to integers, treating each occurrence of the separator as the end
+// of one integer. If the separator is empty, integers are instead separated by any amount of whitespace.
+func Ints(text string, separator string, position Position) ([]int, error) {
+	if separator == "" {
+		return fields(text, position)
+	}
+
+	rawValues := strings.Split(text, separator)
+	values := make([]int, len(rawValues))
+	column := 0
+	for index, rawValue := range rawValues {
+		value, err := Int(rawValue, position.Offset(column))
+		if err != nil {
+			return nil, err
+		}
+		values[index] = value
+		column += len(rawValue) + len(separator)
+	}
+	return values, nil
+}
+
+func fields(text string, position Position) ([]int, error) {
+	var values []int
+	start := -1
+	for column, character := range text + " " {
+		if !unicode.IsSpace(character) {
+			if start == -1 {
+				start = column
+			}
+			continue
+		}
+		if start != -1 {
+			value, err := Int(text[start:column], position.Offset(start))
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+			start = -1
+		}
+	}
+	return values, nil
+}
+
+// Lines reads every line of the input.
+func Lines(reader io.Reader) (lines []string, err error) {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	err = scanner.Err()
+	return
+}
+
+// CommaSeparatedInts reads integers separated by commas from the first line of the input.
+func CommaSeparatedInts(reader io.Reader) ([]int, error) {
+	scanner := bufio.NewScanner(reader)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("input is empty")
+	}
+	return Ints(scanner.Text(), ",", Position{Line: 1, Column: 1})
+}
+
+// IntPerLine reads an integer from every line of the input.
+func IntPerLine(reader io.Reader) ([]int, error) {
+	var values []int
+	scanner := bufio.NewScanner(reader)
+	for line := 1; scanner.Scan(); line++ {
+		value, err := Int(scanner.Text(), Position{Line: line, Column: 1})
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// DigitGrid reads a rectangular grid of single digits, returning the digits row by row along with the width of the
+// grid.
+func DigitGrid(reader io.Reader) (values []int, width int, err error) {
+	scanner := bufio.NewScanner(reader)
+	width = -1
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if width == -1 {
+			width = len(text)
+		} else if width != len(text) {
+			return nil, 0, errorAt(Position{Line: line, Column: 1}, "row is %d wide, but the grid is %d wide", len(text), width)
+		}
+
+		for column := 0; column < len(text); column++ {
+			character := text[column]
+			if character < '0' || character > '9' {
+				return nil, 0, errorAt(Position{Line: line, Column: column + 1}, "%q is not a digit", character)
+			}
+			values = append(values, int(character-'0'))
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, 0, err
+	}
+	if width < 1 {
+		return nil, 0, errors.New("grid is empty")
+	}
+	return values, width, nil
+}
+
+// A Block is a group of consecutive non-blank lines.
+type Block struct {
+	// Line is the line number of the first line in the block.
+	Line  int
+	Lines []string
+}
+
+// Position returns the position of the start of the line with the given index within the block.
+func (block Block) Position(index int) Position {
+	return Position{Line: block.Line + index, Column: 1}
+}
+
+// Blocks reads groups of lines that are separated by one or more blank lines.
+func Blocks(reader io.Reader) (blocks []Block, err error) {
+	scanner := bufio.NewScanner(reader)
+	var block Block
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if text != "" {
+			if len(block.Lines) == 0 {
+				block.Line = line
+			}
+			block.Lines = append(block.Lines, text)
+		} else if len(block.Lines) > 0 {
+			blocks = append(blocks, block)
+			block = Block{}
+		}
+	}
+	if len(block.Lines) > 0 {
+		blocks = append(blocks, block)
+	}
+	err = scanner.Err()
+	return
+}
+
+// A Pair is the text either side of the separator on a line, such as "a" and "b" in "a -> b".
+type Pair struct {
+	Left, Right string
+	// LeftPosition and RightPosition are where each side of the pair starts within the input.
+	LeftPosition, RightPosition Position
+}
+
+// Pairs reads a pair from every line of the input, where each line contains the separator exactly once.
+func Pairs(reader io.Reader, separator string) ([]Pair, error) {
+	var pairs []Pair
+	scanner := bufio.NewScanner(reader)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		start := Position{Line: line, Column: 1}
+		if count := strings.Count(text, separator); count != 1 {
+			return nil, errorAt(start, "expected %q once, but found it %d times in %q", separator, count, text)
+		}
+
+		split := strings.Index(text, separator)
+		pairs = append(pairs, Pair{
+			Left:          text[:split],
+			Right:         text[split+len(separator):],
+			LeftPosition:  start,
+			RightPosition: start.Offset(split + len(separator)),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
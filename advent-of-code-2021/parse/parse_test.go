@@ -0,0 +1,116 @@
+package parse
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestIntsReportsColumnOfBadValue(t *testing.T) {
+	_, err := Ints("12,3x,4", ",", Position{Line: 3, Column: 1})
+	var parseErr *Error
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected a parse error but got %v", err)
+	}
+	if parseErr.Position != (Position{Line: 3, Column: 4}) {
+		t.Errorf("expected the error at line 3, column 4 but got %s", parseErr.Position)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected the error to wrap the syntax error but got %v", err)
+	}
+}
+
+func TestIntsSplitsOnWhitespaceWithoutSeparator(t *testing.T) {
+	values, err := Ints(" 22 13  17", "", Position{Line: 1, Column: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(values, []int{22, 13, 17}) {
+		t.Errorf("unexpected values %v", values)
+	}
+
+	_, err = Ints(" 22 1x", "", Position{Line: 2, Column: 1})
+	if err == nil || err.Error() != `line 2, column 5: "1x" is not an integer. invalid syntax` {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestCommaSeparatedInts(t *testing.T) {
+	values, err := CommaSeparatedInts(strings.NewReader("3,4,3,1,2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(values, []int{3, 4, 3, 1, 2}) {
+		t.Errorf("unexpected values %v", values)
+	}
+	if _, err := CommaSeparatedInts(strings.NewReader("")); err == nil {
+		t.Error("expected an error for empty input")
+	}
+}
+
+func TestIntPerLineReportsLineOfBadValue(t *testing.T) {
+	_, err := IntPerLine(strings.NewReader("199\n200\n\n"))
+	if err == nil || !strings.HasPrefix(err.Error(), "line 3, column 1: ") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestDigitGrid(t *testing.T) {
+	values, width, err := DigitGrid(strings.NewReader("219\n398\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if width != 3 || !reflect.DeepEqual(values, []int{2, 1, 9, 3, 9, 8}) {
+		t.Errorf("unexpected grid %v of width %d", values, width)
+	}
+
+	_, _, err = DigitGrid(strings.NewReader("219\n3a8\n"))
+	if err == nil || err.Error() != `line 2, column 2: 'a' is not a digit` {
+		t.Errorf("unexpected error %v", err)
+	}
+	_, _, err = DigitGrid(strings.NewReader("219\n39\n"))
+	if err == nil || !strings.HasPrefix(err.Error(), "line 2, column 1: ") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestBlocksRecordsFirstLine(t *testing.T) {
+	blocks, err := Blocks(strings.NewReader("7,4,9\n\n\n22 13\n8 2\n\n1 2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Block{
+		{Line: 1, Lines: []string{"7,4,9"}},
+		{Line: 4, Lines: []string{"22 13", "8 2"}},
+		{Line: 7, Lines: []string{"1 2"}},
+	}
+	if !reflect.DeepEqual(blocks, expected) {
+		t.Errorf("expected %v but got %v", expected, blocks)
+	}
+	if blocks[1].Position(1) != (Position{Line: 5, Column: 1}) {
+		t.Errorf("unexpected position %s", blocks[1].Position(1))
+	}
+}
+
+func TestPairs(t *testing.T) {
+	pairs, err := Pairs(strings.NewReader("0,9 -> 5,9\n"), " -> ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Pair{{
+		Left:          "0,9",
+		Right:         "5,9",
+		LeftPosition:  Position{Line: 1, Column: 1},
+		RightPosition: Position{Line: 1, Column: 8},
+	}}
+	if !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("expected %v but got %v", expected, pairs)
+	}
+
+	if _, err := Pairs(strings.NewReader("0,9 -> 5,9\n0,9 5,9\n"), " -> "); err == nil ||
+		!strings.HasPrefix(err.Error(), "line 2, column 1: ") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
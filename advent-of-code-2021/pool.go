@@ -0,0 +1,51 @@
+package main
+
+import "sync"
+
+// runInOrder executes the tasks on a pool of workers, passing each result to the handler in the same order as the
+// tasks regardless of the order in which they complete. If the handler returns false, no further tasks are started
+// and no further results are handled, although any tasks already running are allowed to finish.
+func runInOrder(tasks []func() result, workers int, handle func(result) bool) {
+	results := make([]chan result, len(tasks))
+	for index := range results {
+		results[index] = make(chan result, 1)
+	}
+
+	queue := make(chan int)
+	stop := make(chan struct{})
+	go func() {
+		defer close(queue)
+		for index := range tasks {
+			select {
+			case queue <- index:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	var running sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		running.Add(1)
+		go func() {
+			defer running.Done()
+			for index := range queue {
+				// The queue may still hand out a task after the handler stops, so check before starting it.
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				results[index] <- tasks[index]()
+			}
+		}()
+	}
+
+	for index := range tasks {
+		if !handle(<-results[index]) {
+			close(stop)
+			break
+		}
+	}
+	running.Wait()
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// labelledTask returns a task whose result is labelled with the index, after running the work.
+func labelledTask(index int, work func()) func() result {
+	return func() result {
+		work()
+		return result{inputFilePath: strconv.Itoa(index)}
+	}
+}
+
+func TestRunInOrderHandlesResultsInTaskOrder(t *testing.T) {
+	var tasks []func() result
+	for index := 0; index < 5; index++ {
+		// Later tasks finish first.
+		delay := time.Duration(5-index) * 5 * time.Millisecond
+		tasks = append(tasks, labelledTask(index, func() { time.Sleep(delay) }))
+	}
+
+	var handled []string
+	runInOrder(tasks, len(tasks), func(result result) bool {
+		handled = append(handled, result.inputFilePath)
+		return true
+	})
+
+	if expected := []string{"0", "1", "2", "3", "4"}; !reflect.DeepEqual(handled, expected) {
+		t.Errorf("expected results in the order %v but got %v", expected, handled)
+	}
+}
+
+func TestRunInOrderLimitsRunningTasksToWorkers(t *testing.T) {
+	const workers = 3
+	var running, mostRunning int32
+	var tasks []func() result
+	for index := 0; index < 20; index++ {
+		tasks = append(tasks, labelledTask(index, func() {
+			now := atomic.AddInt32(&running, 1)
+			for {
+				most := atomic.LoadInt32(&mostRunning)
+				if now <= most || atomic.CompareAndSwapInt32(&mostRunning, most, now) {
+					break
+				}
+			}
+			time.Sleep(2 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+		}))
+	}
+
+	handled := 0
+	runInOrder(tasks, workers, func(result) bool {
+		handled++
+		return true
+	})
+
+	if handled != len(tasks) {
+		t.Errorf("expected %d results but got %d", len(tasks), handled)
+	}
+	if mostRunning > workers {
+		t.Errorf("expected at most %d tasks at once but %d ran together", workers, mostRunning)
+	}
+}
+
+func TestRunInOrderStartsNoTasksOnceHandlerStops(t *testing.T) {
+	const workers = 2
+	var mutex sync.Mutex
+	var started []int
+	var tasks []func() result
+	for index := 0; index < 10; index++ {
+		index := index
+		tasks = append(tasks, labelledTask(index, func() {
+			mutex.Lock()
+			started = append(started, index)
+			mutex.Unlock()
+			// Every task after the first is still running when the handler stops.
+			if index > 0 {
+				time.Sleep(20 * time.Millisecond)
+			}
+		}))
+	}
+
+	done := make(chan struct{})
+	handled := 0
+	go func() {
+		defer close(done)
+		runInOrder(tasks, workers, func(result) bool {
+			handled++
+			return false
+		})
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("runInOrder did not return after the handler stopped")
+	}
+
+	if handled != 1 {
+		t.Errorf("expected 1 result to be handled but got %d", handled)
+	}
+	// The first task, plus whichever tasks the workers had already started when it was handled.
+	if len(started) > workers+1 {
+		t.Errorf("expected at most %d tasks to start but %v did", workers+1, started)
+	}
+}
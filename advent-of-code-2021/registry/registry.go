@@ -0,0 +1,124 @@
+// Package registry keeps track of every puzzle solution so that the runner, tests and any other tooling can list
+// them and look them up by year, day and part. Each day package registers its parts from an init function.
+package registry
+
+import (
+	"advent-of-code/answer"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Func solves a single part of a puzzle using the input read from the reader. Solutions should give up, returning the
+// context's error, once the context is done.
+type Func func(ctx context.Context, input io.Reader) (answer.Answer, error)
+
+// SolveFile solves a part of a puzzle using the input stored at the given file path.
+func SolveFile(ctx context.Context, filePath string, solve Func) (answer.Answer, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return answer.Answer{}, fmt.Errorf("could not open input file %q. %w", filePath, err)
+	}
+	defer file.Close()
+	return solve(ctx, file)
+}
+
+// Solution is a registered solution to one part of a puzzle.
+type Solution struct {
+	Year, Day, Part int
+	Solve           Func
+	// DefaultInput is the name of the file within the day's directory that holds the puzzle input.
+	DefaultInput string
+}
+
+// DefaultInputPath returns the path of the default input file, relative to the module root.
+func (solution Solution) DefaultInputPath() string {
+	return filepath.Join(solution.Directory(), solution.DefaultInput)
+}
+
+// SampleInputPath returns the path of the sample input taken from the puzzle description, which is stored as a
+// test.* file in the day's directory. found is false if the day has no sample input.
+func (solution Solution) SampleInputPath() (path string, found bool) {
+	matches, _ := filepath.Glob(filepath.Join(solution.Directory(), "test.*"))
+	if len(matches) == 0 {
+		return "", false
+	}
+	return matches[0], true
+}
+
+// Directory returns the directory of the day package that registered the solution, relative to the module root.
+func (solution Solution) Directory() string {
+	return filepath.Join(fmt.Sprintf("year%d", solution.Year), fmt.Sprintf("day%d", solution.Day))
+}
+
+type key struct {
+	year, day, part int
+}
+
+var solutions = make(map[key]Solution)
+
+// Register adds the solution to a part of a puzzle. It panics if a solution has already been registered for the same
+// year, day and part, as this can only be a programming error.
+func Register(year, day, part int, solve Func, defaultInput string) {
+	k := key{year: year, day: day, part: part}
+	if _, found := solutions[k]; found {
+		panic(fmt.Sprintf("solution for %d day %d part %d registered twice", year, day, part))
+	}
+	solutions[k] = Solution{
+		Year:         year,
+		Day:          day,
+		Part:         part,
+		Solve:        solve,
+		DefaultInput: defaultInput,
+	}
+}
+
+// Lookup returns the solution registered for the given year, day and part, if there is one.
+func Lookup(year, day, part int) (solution Solution, found bool) {
+	solution, found = solutions[key{year: year, day: day, part: part}]
+	return
+}
+
+// Years returns every year that has at least one registered solution, in ascending order.
+func Years() []int {
+	found := make(map[int]bool)
+	for k := range solutions {
+		found[k.year] = true
+	}
+	years := make([]int, 0, len(found))
+	for year := range found {
+		years = append(years, year)
+	}
+	sort.Ints(years)
+	return years
+}
+
+// LatestYear returns the most recent year that has a registered solution, or 0 if nothing has been registered.
+func LatestYear() int {
+	years := Years()
+	if len(years) == 0 {
+		return 0
+	}
+	return years[len(years)-1]
+}
+
+// All returns every registered solution, ordered by year, day and part.
+func All() []Solution {
+	all := make([]Solution, 0, len(solutions))
+	for _, solution := range solutions {
+		all = append(all, solution)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Year != all[j].Year {
+			return all[i].Year < all[j].Year
+		}
+		if all[i].Day != all[j].Day {
+			return all[i].Day < all[j].Day
+		}
+		return all[i].Part < all[j].Part
+	})
+	return all
+}
@@ -0,0 +1,206 @@
+// Package scaffold generates the skeleton of a new day: a package with stub parts that read the input in the shape
+// the puzzle uses, a sample input, a test, and the imports that register the day with the runner.
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var embeddedTemplates embed.FS
+
+// DefaultTemplates holds the built-in templates. Each shape needs a <shape>.go.tmpl template for the day's package
+// and a <shape>.txt.tmpl template for its sample input, and every shape shares test.go.tmpl.
+var DefaultTemplates, _ = fs.Sub(embeddedTemplates, "templates")
+
+// Shapes lists the input shapes supported by the built-in templates.
+var Shapes = []string{"csv", "lines", "grid", "blocks"}
+
+// Day describes the day to generate, and is the data passed to every template.
+type Day struct {
+	// Module is the path of the Go module that the day belongs to.
+	Module string
+	Year   int
+	Day    int
+	// Shape is the shape of the input, such as "lines", which selects the templates to use.
+	Shape string
+	// InputFile is the name of the file within the day's directory that holds the puzzle input.
+	InputFile string
+}
+
+// Package returns the name of the day's package, which is also the name of its directory.
+func (day Day) Package() string {
+	return fmt.Sprintf("day%d", day.Day)
+}
+
+// YearPackage returns the name of the package that registers every day of the day's year, which is also the name of
+// the directory holding those days.
+func (day Day) YearPackage() string {
+	return fmt.Sprintf("year%d", day.Year)
+}
+
+// Directory returns the directory of the day's package, relative to the module root.
+func (day Day) Directory() string {
+	return filepath.Join(day.YearPackage(), day.Package())
+}
+
+// ImportPath returns the path that the day's package is imported with.
+func (day Day) ImportPath() string {
+	return day.Module + "/" + day.YearPackage() + "/" + day.Package()
+}
+
+// YearImportPath returns the path that the package for the day's year is imported with.
+func (day Day) YearImportPath() string {
+	return day.Module + "/" + day.YearPackage()
+}
+
+// YearFile returns the path of the file, relative to the module root, that imports every day of the day's year.
+func (day Day) YearFile() string {
+	return filepath.Join(day.YearPackage(), day.YearPackage()+".go")
+}
+
+const yearFileFormat = `// Package %[1]s registers every day of Advent of Code %[2]d with the registry.
+package %[1]s
+
+// Importing a day package registers its solutions with the registry, so new days only need adding here.
+import (
+)
+`
+
+// GenerateYear creates the package for the day's year within the module root if it does not exist yet, reporting
+// whether it was created. The package starts with an empty import block for Register to add days to.
+func GenerateYear(moduleRoot string, day Day) (bool, error) {
+	filePath := filepath.Join(moduleRoot, day.YearFile())
+	if _, err := os.Stat(filePath); err == nil {
+		return false, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return false, err
+	}
+	content := fmt.Sprintf(yearFileFormat, day.YearPackage(), day.Year)
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Generate writes the files for the day into a new directory within the module root, returning the paths of the
+// files created. It refuses to touch a directory that already exists.
+func Generate(templates fs.FS, moduleRoot string, day Day) ([]string, error) {
+	directory := filepath.Join(moduleRoot, day.Directory())
+	if _, err := os.Stat(directory); err == nil {
+		return nil, fmt.Errorf("%s already exists", directory)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	files := []struct {
+		name, template string
+		isGo           bool
+	}{
+		{name: day.Package() + ".go", template: day.Shape + ".go.tmpl", isGo: true},
+		{name: day.Package() + "_test.go", template: "test.go.tmpl", isGo: true},
+		{name: "test.txt", template: day.Shape + ".txt.tmpl"},
+	}
+
+	rendered := make([][]byte, len(files))
+	for index, file := range files {
+		content, err := render(templates, file.template, day)
+		if err != nil {
+			return nil, err
+		}
+		if file.isGo {
+			if content, err = format.Source(content); err != nil {
+				return nil, fmt.Errorf("template %s does not produce valid Go. %w", file.template, err)
+			}
+		}
+		rendered[index] = content
+	}
+
+	if err := os.MkdirAll(filepath.Dir(directory), 0755); err != nil {
+		return nil, err
+	}
+	if err := os.Mkdir(directory, 0755); err != nil {
+		return nil, err
+	}
+	created := make([]string, len(files))
+	for index, file := range files {
+		created[index] = filepath.Join(directory, file.name)
+		if err := os.WriteFile(created[index], rendered[index], 0644); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+func render(templates fs.FS, name string, day Day) ([]byte, error) {
+	tmpl, err := template.ParseFS(templates, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not load template %s. %w", name, err)
+	}
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, day); err != nil {
+		return nil, fmt.Errorf("could not render template %s. %w", name, err)
+	}
+	return buffer.Bytes(), nil
+}
+
+var importBlockPattern = regexp.MustCompile(`(?m)^import \($`)
+
+// Register adds a blank import of the package with the given import path to the given Go file, which must contain an
+// import block, so that the package registers its solutions with the runner. Nothing is changed if the import is
+// already present.
+func Register(filePath string, importPath string) error {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	importLine := fmt.Sprintf("_ %q", importPath)
+	if strings.Contains(string(content), importLine) {
+		return nil
+	}
+
+	location := importBlockPattern.FindIndex(content)
+	if location == nil {
+		return fmt.Errorf("%s has no import block", filePath)
+	}
+	var updated bytes.Buffer
+	updated.Write(content[:location[1]])
+	updated.WriteString("\n\t" + importLine)
+	updated.Write(content[location[1]:])
+
+	formatted, err := format.Source(updated.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not format %s. %w", filePath, err)
+	}
+	return os.WriteFile(filePath, formatted, 0644)
+}
+
+var modulePattern = regexp.MustCompile(`(?m)^module\s+(\S+)`)
+
+// ReadModulePath returns the module path declared in the go.mod file in the module root.
+func ReadModulePath(moduleRoot string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(moduleRoot, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	match := modulePattern.FindSubmatch(content)
+	if match == nil {
+		return "", errors.New("go.mod does not declare a module")
+	}
+	return string(match[1]), nil
+}
@@ -0,0 +1,90 @@
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateEveryShape(t *testing.T) {
+	moduleRoot := t.TempDir()
+	for index, shape := range Shapes {
+		day := Day{Module: "example", Year: 2021, Day: index + 1, Shape: shape, InputFile: "input.txt"}
+		created, err := Generate(DefaultTemplates, moduleRoot, day)
+		if err != nil {
+			t.Fatalf("failed to generate %s day: %v", shape, err)
+		}
+		if len(created) != 3 {
+			t.Errorf("expected 3 files for %s day but got %v", shape, created)
+		}
+
+		content, err := os.ReadFile(filepath.Join(moduleRoot, day.Directory(), day.Package()+".go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(content), `registry.Register(2021, `) {
+			t.Errorf("%s day does not register its parts:\n%s", shape, content)
+		}
+	}
+}
+
+func TestGenerateRefusesExistingDirectory(t *testing.T) {
+	moduleRoot := t.TempDir()
+	day := Day{Module: "example", Year: 2021, Day: 1, Shape: "lines", InputFile: "input.txt"}
+	if err := os.MkdirAll(filepath.Join(moduleRoot, "year2021", "day1"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Generate(DefaultTemplates, moduleRoot, day); err == nil {
+		t.Fatal("expected an error when the day already exists")
+	}
+}
+
+func TestRegisterAddsImportOnce(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "year2021.go")
+	original := "package year2021\n\nimport (\n\t_ \"example/year2021/day1\"\n\t_ \"example/year2021/day2\"\n)\n"
+	if err := os.WriteFile(filePath, []byte(original), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	day := Day{Module: "example", Year: 2021, Day: 10}
+	for i := 0; i < 2; i++ {
+		if err := Register(filePath, day.ImportPath()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "package year2021\n\nimport (\n\t_ \"example/year2021/day1\"\n\t_ \"example/year2021/day10\"\n\t_ \"example/year2021/day2\"\n)\n"
+	if string(content) != expected {
+		t.Errorf("expected:\n%s\nactual:\n%s", expected, content)
+	}
+}
+
+func TestGenerateYearCreatesRegisterablePackage(t *testing.T) {
+	moduleRoot := t.TempDir()
+	day := Day{Module: "example", Year: 2022, Day: 1}
+
+	created, err := GenerateYear(moduleRoot, day)
+	if err != nil || !created {
+		t.Fatalf("expected the year to be created, but got %v, %v", created, err)
+	}
+	if created, err = GenerateYear(moduleRoot, day); err != nil || created {
+		t.Fatalf("expected the existing year to be left alone, but got %v, %v", created, err)
+	}
+
+	filePath := filepath.Join(moduleRoot, day.YearFile())
+	if err := Register(filePath, day.ImportPath()); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "package year2022\n") || !strings.Contains(string(content), `_ "example/year2022/day1"`) {
+		t.Errorf("unexpected year package:\n%s", content)
+	}
+}
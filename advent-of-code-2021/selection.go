@@ -0,0 +1,102 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A selection describes which years, days and parts have been requested on the command line.
+type selection struct {
+	year int
+	days map[int]bool
+	part int
+}
+
+// newSelection validates the requested year, days and parts. A year of 0 selects every year when running all days,
+// and the latest year otherwise.
+func newSelection(year int, daySpec string, part int, all bool, latestYear int) (*selection, error) {
+	if year < 0 {
+		return nil, fmt.Errorf("year must not be negative, but was %d", year)
+	}
+	if part < 0 || part > 2 {
+		return nil, fmt.Errorf("part must be 1 or 2, but was %d", part)
+	}
+	if all {
+		if daySpec != "" {
+			return nil, errors.New("-all cannot be combined with -day")
+		}
+		return &selection{year: year, part: part}, nil
+	}
+	if daySpec == "" {
+		return nil, errors.New("either -day or -all must be specified")
+	}
+
+	days, err := parseDays(daySpec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid day selection %q. %w", daySpec, err)
+	}
+	if year == 0 {
+		year = latestYear
+	}
+	return &selection{year: year, days: days, part: part}, nil
+}
+
+// includes reports whether the given year, day and part have been selected. A selection with no year includes every
+// year, a selection with no days includes every day, and a selection with no part includes both parts.
+func (selection *selection) includes(year, day, part int) bool {
+	if selection.year != 0 && selection.year != year {
+		return false
+	}
+	if selection.days != nil && !selection.days[day] {
+		return false
+	}
+	return selection.part == 0 || selection.part == part
+}
+
+// parseDays parses a comma-separated list of days or inclusive day ranges, such as "1,3-5".
+func parseDays(daySpec string) (map[int]bool, error) {
+	days := make(map[int]bool)
+	for _, component := range strings.Split(daySpec, ",") {
+		first, last, err := parseDayRange(strings.TrimSpace(component))
+		if err != nil {
+			return nil, err
+		}
+		for day := first; day <= last; day++ {
+			days[day] = true
+		}
+	}
+	return days, nil
+}
+
+func parseDayRange(rawRange string) (first, last int, err error) {
+	bounds := strings.SplitN(rawRange, "-", 2)
+	first, err = parseDay(bounds[0])
+	if err != nil {
+		return
+	}
+	if len(bounds) == 1 {
+		last = first
+		return
+	}
+	last, err = parseDay(bounds[1])
+	if err != nil {
+		return
+	}
+	if last < first {
+		err = fmt.Errorf("range %q ends before it starts", rawRange)
+	}
+	return
+}
+
+func parseDay(rawDay string) (int, error) {
+	day, err := strconv.Atoi(rawDay)
+	if err != nil {
+		return 0, fmt.Errorf("day %q is not a number", rawDay)
+	}
+	if day < 1 || day > 25 {
+		return 0, fmt.Errorf("day %d is not between 1 and 25", day)
+	}
+	return day, nil
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"advent-of-code/year2021/day1"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// sonarCommand counts the 2021 day 1 windows of any size that changed in a chosen way from the previous window,
+// optionally listing where. It can stream huge or live inputs with a running count, or instead report statistics and
+// a histogram of the changes between measurements.
+func sonarCommand(args []string) int {
+	flags := flag.NewFlagSet("sonar", flag.ExitOnError)
+	inputFilePath := flags.String("input", defaultInputPath(2021, 1), "the file of measurements, or - for stdin")
+	window := flags.Int("window", 1, "the number of consecutive measurements to sum before comparing")
+	comparisonName := flags.String("compare", string(day1.Increase), "the change to count: "+comparisonNames())
+	showIndices := flags.Bool("indices", false, "list the index of the measurement that completes each counted window")
+	stream := flags.Bool("stream", false, "process measurements as they are read, without keeping them, for huge or live inputs")
+	every := flags.Int("every", 0, "with -stream, report the running count after every this many measurements")
+	showStats := flags.Bool("stats", false, "report statistics about the measurements instead of counting windows")
+	buckets := flags.Int("buckets", 10, "with -stats, the number of ranges in the histogram of changes between measurements")
+	flags.Parse(args)
+
+	setFlags := map[string]bool{}
+	flags.Visit(func(setFlag *flag.Flag) {
+		setFlags[setFlag.Name] = true
+	})
+
+	comparison, err := day1.ParseComparison(*comparisonName)
+	if err == nil && *window < 1 {
+		err = fmt.Errorf("-window must be at least 1, but was %d", *window)
+	}
+	if err == nil && *every < 0 {
+		err = fmt.Errorf("-every must not be negative, but was %d", *every)
+	}
+	if err == nil && *every > 0 && !*stream {
+		err = errors.New("-every can only be used with -stream")
+	}
+	if err == nil && *showStats && *stream {
+		err = errors.New("-stats needs every measurement, so cannot be combined with -stream")
+	}
+	for _, name := range []string{"window", "compare", "indices"} {
+		if err == nil && *showStats && setFlags[name] {
+			err = fmt.Errorf("-stats reports on the measurements themselves, so cannot be combined with -%s", name)
+		}
+	}
+	if err == nil && setFlags["buckets"] && !*showStats {
+		err = errors.New("-buckets can only be used with -stats")
+	}
+	if err == nil && *buckets < 1 {
+		err = fmt.Errorf("-buckets must be at least 1, but was %d", *buckets)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 2
+	}
+
+	input, err := openInput(*inputFilePath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+	defer input.Close()
+
+	if *stream {
+		return streamSonar(input, *window, comparison, *showIndices, *every)
+	}
+	if *showStats {
+		report, err := day1.ReportFromReader(input)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			return 1
+		}
+		fmt.Print(report)
+		fmt.Printf("\nChanges between measurements:\n%s", report.Histogram(*buckets, 40))
+		return 0
+	}
+
+	measurements, err := day1.ReadMeasurements(input)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+
+	changes, err := day1.WindowChanges(measurements, *window, comparison)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+	fmt.Printf("Windows of %d compared with the previous window: %d %s\n", *window, len(changes), comparison)
+	if *showIndices {
+		fmt.Printf("Indices: %s\n", joinInts(changes))
+	}
+	return 0
+}
+
+// streamSonar counts the windows as the measurements are read, printing each counted index as soon as it is found if
+// showIndices is set, and the running count after every so many measurements if every is non-zero.
+func streamSonar(input io.Reader, window int, comparison day1.Comparison, showIndices bool, every int) int {
+	counter, err := day1.NewWindowCounter(window, comparison)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+
+	err = day1.StreamMeasurements(context.Background(), input, counter, func(index int, counted bool) {
+		if counted && showIndices {
+			fmt.Printf("Index %d\n", index)
+		}
+		if every > 0 && counter.Seen()%every == 0 {
+			fmt.Printf("After %d measurements: %d %s\n", counter.Seen(), counter.Count(), comparison)
+		}
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+	fmt.Printf("Windows of %d compared with the previous window: %d %s\n", window, counter.Count(), comparison)
+	return 0
+}
+
+func comparisonNames() string {
+	names := make([]string, len(day1.Comparisons))
+	for index, comparison := range day1.Comparisons {
+		names[index] = string(comparison)
+	}
+	return strings.Join(names, ", ")
+}
+
+func joinInts(values []int) string {
+	formatted := make([]string, len(values))
+	for index, value := range values {
+		formatted[index] = strconv.Itoa(value)
+	}
+	return strings.Join(formatted, ", ")
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"advent-of-code/registry"
+	"advent-of-code/year2021/day2"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// submarineCommand follows the 2021 day 2 commands under one or every interpretation, reporting where each submarine
+// ends up. It can skip malformed lines instead of failing, record every step as CSV or JSON, and draw the courses as
+// an SVG.
+func submarineCommand(args []string) int {
+	flags := flag.NewFlagSet("submarine", flag.ExitOnError)
+	inputFilePath := flags.String("input", defaultInputPath(2021, 2), "the file of commands to follow, or - for stdin")
+	lenient := flags.Bool("lenient", false, "skip lines that are not valid commands and report them afterwards")
+	interpretation := flags.String(
+		"interpretation", "",
+		"how to understand the commands: "+strings.Join(day2.InterpretationNames(), ", ")+". Every one is used if omitted",
+	)
+	traceFilePath := flags.String("trace", "", "a file to record the position after every command in, or - for stdout")
+	traceFormat := flags.String("format", csvOutput, "the format to record the trace in: csv or json")
+	svgFilePath := flags.String("svg", "", "a file to draw the course of every interpretation in, as an SVG image")
+	flags.Parse(args)
+
+	names := day2.InterpretationNames()
+	if *interpretation != "" {
+		if _, found := day2.Interpretations[*interpretation]; !found {
+			fmt.Fprintf(os.Stderr, "unknown interpretation %q\n", *interpretation)
+			return 2
+		}
+		names = []string{*interpretation}
+	}
+	if *traceFilePath != "" && len(names) != 1 {
+		fmt.Fprintln(os.Stderr, "-trace can only record one interpretation, so needs -interpretation")
+		return 2
+	}
+	if *traceFormat != csvOutput && *traceFormat != jsonOutput {
+		fmt.Fprintf(os.Stderr, "unsupported trace format %q\n", *traceFormat)
+		return 2
+	}
+
+	// The trace takes over stdout if it is written there, so everything else is reported on stderr instead.
+	report := os.Stdout
+	if *traceFilePath == stdoutPath {
+		report = os.Stderr
+	}
+
+	source := newFileSource(*inputFilePath)
+	if *inputFilePath == stdinPath {
+		var err error
+		if source, err = newStdinSource(); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			return 1
+		}
+	}
+
+	status := 0
+	var courses []day2.Course
+	for _, name := range names {
+		submarine := day2.Interpretations[name]()
+		trajectory, err := followSubmarineCommands(source, submarine, *lenient)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", name, err.Error())
+			status = 1
+			continue
+		}
+		fmt.Fprintf(report, "%s: %s\n", name, day2.FinalAnswer(submarine))
+		courses = append(courses, day2.Course{Name: name, Trajectory: trajectory})
+
+		if *traceFilePath != "" {
+			if err := writeTrajectory(trajectory, *traceFilePath, *traceFormat); err != nil {
+				fmt.Fprintf(os.Stderr, "could not write trace. %s\n", err.Error())
+				return 1
+			}
+			fmt.Fprintf(report, "%s: %s\n", name, trajectory.Stats())
+		}
+	}
+
+	if *svgFilePath != "" && len(courses) > 0 {
+		if err := writeCourses(courses, *svgFilePath); err != nil {
+			fmt.Fprintf(os.Stderr, "could not draw the course. %s\n", err.Error())
+			return 1
+		}
+		fmt.Fprintf(report, "Drew the course in %s\n", *svgFilePath)
+	}
+	return status
+}
+
+func writeCourses(courses []day2.Course, filePath string) error {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	if err := day2.WriteSVG(file, courses); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func followSubmarineCommands(source inputSource, submarine day2.Submarine, lenient bool) (day2.Trajectory, error) {
+	input, err := source.open()
+	if err != nil {
+		return nil, err
+	}
+	defer input.Close()
+
+	commands, skipped, err := day2.ReadCommands(input, submarine.Instructions(), lenient)
+	if err != nil {
+		return nil, fmt.Errorf("could not read commands. %w", err)
+	}
+	for _, parseErr := range skipped {
+		fmt.Fprintf(os.Stderr, "Skipped %s\n", parseErr.Error())
+	}
+	return day2.Trace(submarine, commands)
+}
+
+func writeTrajectory(trajectory day2.Trajectory, filePath string, format string) error {
+	output := os.Stdout
+	if filePath != stdoutPath {
+		file, err := os.Create(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		output = file
+	}
+
+	if format == jsonOutput {
+		return trajectory.WriteJSON(output)
+	}
+	return trajectory.WriteCSV(output)
+}
+
+// defaultInputPath returns the default input of the given day, or an empty path if the day has no registered solution.
+func defaultInputPath(year, day int) string {
+	solution, found := registry.Lookup(year, day, 1)
+	if !found {
+		return ""
+	}
+	return solution.DefaultInputPath()
+}
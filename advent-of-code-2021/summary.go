@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// printSummary writes a table showing whether each executed part succeeded or failed, along with the verdict of
+// each part if answers were verified. The time taken by each part is included if showTiming is set.
+func printSummary(writer io.Writer, results []result, showTiming bool) {
+	succeeded := 0
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	fmt.Fprint(table, "Year\tDay\tPart\tInput\tVerdict\t")
+	if showTiming {
+		fmt.Fprint(table, "Time\t")
+	}
+	fmt.Fprintln(table, "Status")
+
+	for _, result := range results {
+		verdict := result.verdict
+		if verdict == "" {
+			verdict = "-"
+		}
+		fmt.Fprintf(table, "%d\t%d\t%d\t%s\t%s\t", result.solution.Year, result.solution.Day, result.solution.Part, result.inputFilePath, verdict)
+
+		if showTiming {
+			fmt.Fprintf(table, "%s\t", result.duration().Round(time.Microsecond))
+		}
+
+		status := "OK"
+		if result.timedOut() {
+			status = "TIMED OUT"
+		} else if result.err != nil {
+			status = "FAILED: " + result.err.Error()
+		}
+		fmt.Fprintln(table, status)
+
+		if result.succeeded() {
+			succeeded++
+		}
+	}
+	table.Flush()
+	fmt.Fprintf(writer, "\n%d of %d parts succeeded\n", succeeded, len(results))
+}
+
+func anyFailed(results []result) bool {
+	for _, result := range results {
+		if !result.succeeded() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"time"
+)
+
+// A measurement records the cost of a single run of a part.
+type measurement struct {
+	duration       time.Duration
+	allocations    uint64
+	allocatedBytes uint64
+}
+
+// measure runs the function, recording how long it took and how much memory it allocated.
+func measure(f func()) measurement {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	f()
+	duration := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	return measurement{
+		duration:       duration,
+		allocations:    after.Mallocs - before.Mallocs,
+		allocatedBytes: after.TotalAlloc - before.TotalAlloc,
+	}
+}
+
+func (measurement measurement) String() string {
+	return fmt.Sprintf(
+		"%s, %d allocations, %s allocated",
+		measurement.duration.Round(time.Microsecond), measurement.allocations, formatBytes(measurement.allocatedBytes),
+	)
+}
+
+// A benchmark summarises the durations of repeated runs of a part.
+type benchmark struct {
+	runs             int
+	min, median, max time.Duration
+}
+
+func newBenchmark(durations []time.Duration) benchmark {
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	median := sorted[len(sorted)/2]
+	if len(sorted)%2 == 0 {
+		median = (sorted[len(sorted)/2-1] + median) / 2
+	}
+
+	return benchmark{
+		runs:   len(sorted),
+		min:    sorted[0],
+		median: median,
+		max:    sorted[len(sorted)-1],
+	}
+}
+
+func (benchmark benchmark) String() string {
+	return fmt.Sprintf(
+		"%d runs: min %s, median %s, max %s",
+		benchmark.runs,
+		benchmark.min.Round(time.Microsecond),
+		benchmark.median.Round(time.Microsecond),
+		benchmark.max.Round(time.Microsecond),
+	)
+}
+
+func formatBytes(bytes uint64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	value := float64(bytes) / unit
+	for _, suffix := range []string{"KiB", "MiB", "GiB"} {
+		if value < unit {
+			return fmt.Sprintf("%.1f %s", value, suffix)
+		}
+		value /= unit
+	}
+	return fmt.Sprintf("%.1f TiB", value)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// A verdict is the outcome of comparing an answer to the known-correct answer for the same input.
+type verdict string
+
+const (
+	pass    verdict = "PASS"
+	fail    verdict = "FAIL"
+	unknown verdict = "UNKNOWN"
+)
+
+type expectedAnswer struct {
+	Year  int    `json:"year"`
+	Day   int    `json:"day"`
+	Part  int    `json:"part"`
+	Input string `json:"input"`
+	// Answer is the value of the answer, formatted as it would be submitted.
+	Answer string `json:"answer"`
+}
+
+type answerKey struct {
+	year, day, part int
+	input           string
+}
+
+// answerBook holds the known-correct answers for each part, keyed by the input file they were produced from.
+type answerBook map[answerKey]string
+
+func loadAnswers(filePath string) (answerBook, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read answers file %q. %w", filePath, err)
+	}
+
+	var expectedAnswers []expectedAnswer
+	if err := json.Unmarshal(content, &expectedAnswers); err != nil {
+		return nil, fmt.Errorf("could not parse answers file %q. %w", filePath, err)
+	}
+
+	answers := make(answerBook)
+	for _, expected := range expectedAnswers {
+		k := newAnswerKey(expected.Year, expected.Day, expected.Part, expected.Input)
+		if _, found := answers[k]; found {
+			return nil, fmt.Errorf(
+				"answers file %q contains more than one answer for %d day %d part %d with input %q",
+				filePath, expected.Year, expected.Day, expected.Part, expected.Input,
+			)
+		}
+		answers[k] = expected.Answer
+	}
+	return answers, nil
+}
+
+func newAnswerKey(year, day, part int, inputFilePath string) answerKey {
+	return answerKey{
+		year:  year,
+		day:   day,
+		part:  part,
+		input: filepath.ToSlash(filepath.Clean(inputFilePath)),
+	}
+}
+
+// verify compares the result to the known-correct answer, which is also returned if there is one. Results for which
+// no answer is known are UNKNOWN, and results that failed to produce an answer at all are FAIL.
+func (answers answerBook) verify(result result) (verdict, string) {
+	solution := result.solution
+	expected, found := answers[newAnswerKey(solution.Year, solution.Day, solution.Part, result.inputFilePath)]
+	switch {
+	case result.err != nil:
+		return fail, expected
+	case !found:
+		return unknown, ""
+	case expected != result.answer.ValueString():
+		return fail, expected
+	default:
+		return pass, expected
+	}
+}
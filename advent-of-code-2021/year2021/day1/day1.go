@@ -0,0 +1,119 @@
+package day1
+
+import (
+	"advent-of-code/answer"
+	"advent-of-code/parse"
+	"advent-of-code/registry"
+	"context"
+	"fmt"
+	"io"
+)
+
+func init() {
+	registry.Register(2021, 1, 1, Part1FromReader, "measurements.csv")
+	registry.Register(2021, 1, 2, Part2FromReader, "measurements.csv")
+}
+
+func Part1(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part1FromReader)
+}
+
+func Part1FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	measurements, err := getMeasurements(reader)
+	if err != nil {
+		return answer.Answer{}, err
+	}
+	return answer.New(numberOfIncreases(measurements), "Increases"), nil
+}
+
+func Part2(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part2FromReader)
+}
+
+func Part2FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	measurements, err := getMeasurements(reader)
+	if err != nil {
+		return answer.Answer{}, err
+	}
+	return answer.New(numberOfIncreasesInSlidingWindow(measurements), "Increases"), nil
+}
+
+func getMeasurements(reader io.Reader) (*[]int, error) {
+	measurements, err := ReadMeasurements(reader)
+	if err != nil {
+		return nil, err
+	}
+	return &measurements, nil
+}
+
+// ReadMeasurements reads a measurement from every line of the input.
+func ReadMeasurements(reader io.Reader) ([]int, error) {
+	measurements, err := parse.IntPerLine(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read measurements. %w", err)
+	}
+	return measurements, nil
+}
+
+func numberOfIncreases(measurements *[]int) int {
+	changes, _ := WindowChanges(*measurements, 1, Increase)
+	return len(changes)
+}
+
+func numberOfIncreasesInSlidingWindow(measurements *[]int) int {
+	changes, _ := WindowChanges(*measurements, 3, Increase)
+	return len(changes)
+}
+
+// A Comparison is a way that the sum of one window of measurements can relate to the sum of the window before it.
+type Comparison string
+
+const (
+	Increase  Comparison = "increase"
+	Decrease  Comparison = "decrease"
+	Unchanged Comparison = "unchanged"
+)
+
+// Comparisons lists every comparison that can be made.
+var Comparisons = []Comparison{Increase, Decrease, Unchanged}
+
+// ParseComparison returns the comparison with the given name.
+func ParseComparison(name string) (Comparison, error) {
+	for _, comparison := range Comparisons {
+		if string(comparison) == name {
+			return comparison, nil
+		}
+	}
+	return "", fmt.Errorf("unknown comparison %q", name)
+}
+
+// matches reports whether a window whose sum is current relates to the previous window, whose sum is previous, in
+// the way described by the comparison.
+func (comparison Comparison) matches(previous, current int) bool {
+	switch comparison {
+	case Increase:
+		return current > previous
+	case Decrease:
+		return current < previous
+	default:
+		return current == previous
+	}
+}
+
+// WindowChanges compares the sum of every window of consecutive measurements of the given size with the sum of the
+// window one measurement earlier, returning the index of the measurement that completes each window whose sum
+// compares in the given way. Indexes start from 0.
+func WindowChanges(measurements []int, window int, comparison Comparison) ([]int, error) {
+	counter, err := NewWindowCounter(window, comparison)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []int
+	for index, measurement := range measurements {
+		if counter.Add(measurement) {
+			changes = append(changes, index)
+		}
+	}
+	return changes, nil
+}
@@ -0,0 +1,118 @@
+package day1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var sampleMeasurements = []int{199, 200, 208, 210, 200, 207, 240, 269, 260, 263}
+
+func TestWindowChanges(t *testing.T) {
+	tests := []struct {
+		window     int
+		comparison Comparison
+		expected   []int
+	}{
+		{window: 1, comparison: Increase, expected: []int{1, 2, 3, 5, 6, 7, 9}},
+		{window: 1, comparison: Decrease, expected: []int{4, 8}},
+		{window: 1, comparison: Unchanged, expected: nil},
+		{window: 3, comparison: Increase, expected: []int{3, 6, 7, 8, 9}},
+		{window: 3, comparison: Decrease, expected: []int{5}},
+		{window: 3, comparison: Unchanged, expected: []int{4}},
+		{window: 10, comparison: Increase, expected: nil},
+	}
+	for _, test := range tests {
+		changes, err := WindowChanges(sampleMeasurements, test.window, test.comparison)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(changes, test.expected) {
+			t.Errorf("expected windows of %d to %s at %v but got %v", test.window, test.comparison, test.expected, changes)
+		}
+	}
+
+	if _, err := WindowChanges(sampleMeasurements, 0, Increase); err == nil {
+		t.Error("expected an error for a window of 0")
+	}
+}
+
+func TestStreamMeasurementsMatchesWindowChanges(t *testing.T) {
+	var input strings.Builder
+	for _, measurement := range sampleMeasurements {
+		fmt.Fprintln(&input, measurement)
+	}
+
+	for _, comparison := range Comparisons {
+		expected, err := WindowChanges(sampleMeasurements, 3, comparison)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		counter, err := NewWindowCounter(3, comparison)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var indices []int
+		err = StreamMeasurements(context.Background(), strings.NewReader(input.String()), counter, func(index int, counted bool) {
+			if counted {
+				indices = append(indices, index)
+			}
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(indices, expected) || counter.Count() != len(expected) {
+			t.Errorf("expected %s at %v but streaming found %v (count %d)", comparison, expected, indices, counter.Count())
+		}
+		if counter.Seen() != len(sampleMeasurements) {
+			t.Errorf("expected %d measurements to be seen but got %d", len(sampleMeasurements), counter.Seen())
+		}
+	}
+}
+
+func TestStreamMeasurementsReportsBadLine(t *testing.T) {
+	counter, _ := NewWindowCounter(1, Increase)
+	err := StreamMeasurements(context.Background(), strings.NewReader("1\n2\nthree\n"), counter, nil)
+	if err == nil || !strings.Contains(err.Error(), "line 3, column 1") {
+		t.Errorf("expected an error on line 3 but got %v", err)
+	}
+}
+
+func TestReportSummarisesMeasurements(t *testing.T) {
+	report, err := NewReport(sampleMeasurements)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Measurements != 10 || report.Min != 199 || report.Max != 269 {
+		t.Errorf("unexpected count or range %+v", report)
+	}
+	if report.Mean != 225.6 || report.Median != 209 {
+		t.Errorf("expected a mean of 225.6 and median of 209 but got %v and %v", report.Mean, report.Median)
+	}
+	if report.LongestIncrease != (Run{Start: 0, End: 3}) || report.LongestDecrease != (Run{Start: 3, End: 4}) {
+		t.Errorf("unexpected runs %+v and %+v", report.LongestIncrease, report.LongestDecrease)
+	}
+	if report.LargestJump != (Jump{Index: 6, Delta: 33}) {
+		t.Errorf("unexpected largest jump %+v", report.LargestJump)
+	}
+
+	expectedHistogram := "" +
+		"   -10 to      0 | ##   2\n" +
+		"     1 to     11 | #### 5\n" +
+		"    12 to     22 |      0\n" +
+		"    23 to     33 | ##   2\n"
+	if histogram := report.Histogram(4, 4); histogram != expectedHistogram {
+		t.Errorf("expected histogram:\n%s\nactual:\n%s", expectedHistogram, histogram)
+	}
+}
+
+func TestReportFromReaderRejectsEmptyInput(t *testing.T) {
+	if _, err := ReportFromReader(strings.NewReader("")); err == nil {
+		t.Error("expected an error when there are no measurements")
+	}
+}
@@ -0,0 +1,169 @@
+package day1
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// A Run is a stretch of consecutive measurements where every measurement changes in the same direction from the one
+// before. Start and End are the indexes of the first and last measurements in the run.
+type Run struct {
+	Start, End int
+}
+
+// Changes returns the number of changes in the run, which is one fewer than the number of measurements it spans.
+func (run Run) Changes() int {
+	return run.End - run.Start
+}
+
+// A Jump is the change from one measurement to the next, where Index is the index of the later measurement.
+type Jump struct {
+	Index int
+	Delta int
+}
+
+// A Report summarises a series of measurements.
+type Report struct {
+	Measurements int
+	Min, Max     int
+	Mean         float64
+	Median       float64
+	// LongestIncrease and LongestDecrease are the earliest of the longest runs in each direction.
+	LongestIncrease Run
+	LongestDecrease Run
+	// LargestJump is the earliest change with the largest size, in either direction.
+	LargestJump Jump
+	// Deltas holds the change from each measurement to the next.
+	Deltas []int
+}
+
+// ReportFromReader reads the measurements and summarises them.
+func ReportFromReader(reader io.Reader) (Report, error) {
+	measurements, err := getMeasurements(reader)
+	if err != nil {
+		return Report{}, err
+	}
+	return NewReport(*measurements)
+}
+
+// NewReport summarises the measurements, of which there must be at least one.
+func NewReport(measurements []int) (Report, error) {
+	if len(measurements) == 0 {
+		return Report{}, errors.New("there are no measurements to report on")
+	}
+
+	report := Report{Measurements: len(measurements), Min: measurements[0], Max: measurements[0]}
+	sum := 0
+	increase, decrease := Run{}, Run{}
+	for index, measurement := range measurements {
+		sum += measurement
+		if measurement < report.Min {
+			report.Min = measurement
+		}
+		if measurement > report.Max {
+			report.Max = measurement
+		}
+		if index == 0 {
+			continue
+		}
+
+		delta := measurement - measurements[index-1]
+		report.Deltas = append(report.Deltas, delta)
+		if index == 1 || abs(delta) > abs(report.LargestJump.Delta) {
+			report.LargestJump = Jump{Index: index, Delta: delta}
+		}
+
+		increase = extendRun(increase, index, delta > 0)
+		if increase.Changes() > report.LongestIncrease.Changes() {
+			report.LongestIncrease = increase
+		}
+		decrease = extendRun(decrease, index, delta < 0)
+		if decrease.Changes() > report.LongestDecrease.Changes() {
+			report.LongestDecrease = decrease
+		}
+	}
+	report.Mean = float64(sum) / float64(len(measurements))
+	report.Median = median(measurements)
+	return report, nil
+}
+
+// extendRun adds the measurement at the index to the run if it continues the run, or starts a new run from it if not.
+func extendRun(run Run, index int, continues bool) Run {
+	if continues {
+		return Run{Start: run.Start, End: index}
+	}
+	return Run{Start: index, End: index}
+}
+
+func median(measurements []int) float64 {
+	sorted := append([]int(nil), measurements...)
+	sort.Ints(sorted)
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return float64(sorted[middle-1]+sorted[middle]) / 2
+	}
+	return float64(sorted[middle])
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+// Histogram draws the number of deltas that fall into each of the given number of equal ranges, one line per range,
+// with the longest bar the given width.
+func (report Report) Histogram(buckets, width int) string {
+	if len(report.Deltas) == 0 || buckets < 1 {
+		return ""
+	}
+
+	minDelta, maxDelta := report.Deltas[0], report.Deltas[0]
+	for _, delta := range report.Deltas {
+		if delta < minDelta {
+			minDelta = delta
+		}
+		if delta > maxDelta {
+			maxDelta = delta
+		}
+	}
+	bucketSize := (maxDelta - minDelta + buckets) / buckets
+
+	counts := make([]int, buckets)
+	largestCount := 0
+	for _, delta := range report.Deltas {
+		bucket := (delta - minDelta) / bucketSize
+		counts[bucket]++
+		if counts[bucket] > largestCount {
+			largestCount = counts[bucket]
+		}
+	}
+
+	var histogram strings.Builder
+	for bucket, count := range counts {
+		low := minDelta + bucket*bucketSize
+		bar := strings.Repeat("#", (count*width+largestCount-1)/largestCount)
+		fmt.Fprintf(&histogram, "%6d to %6d | %-*s %d\n", low, low+bucketSize-1, width, bar, count)
+	}
+	return histogram.String()
+}
+
+func (report Report) String() string {
+	var text strings.Builder
+	fmt.Fprintf(&text, "Measurements: %d\n", report.Measurements)
+	fmt.Fprintf(&text, "Min: %d\nMax: %d\nMean: %.2f\nMedian: %.1f\n", report.Min, report.Max, report.Mean, report.Median)
+	fmt.Fprintf(
+		&text, "Longest increasing run: %d increases, from index %d to %d\n",
+		report.LongestIncrease.Changes(), report.LongestIncrease.Start, report.LongestIncrease.End,
+	)
+	fmt.Fprintf(
+		&text, "Longest decreasing run: %d decreases, from index %d to %d\n",
+		report.LongestDecrease.Changes(), report.LongestDecrease.Start, report.LongestDecrease.End,
+	)
+	fmt.Fprintf(&text, "Largest jump: %+d, to index %d\n", report.LargestJump.Delta, report.LargestJump.Index)
+	return text.String()
+}
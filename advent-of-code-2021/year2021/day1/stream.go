@@ -0,0 +1,76 @@
+package day1
+
+import (
+	"advent-of-code/parse"
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+)
+
+// A WindowCounter counts the windows whose sums compare with the previous window's sum in a given way, as
+// measurements arrive one at a time. It only remembers as many measurements as there are in a window.
+type WindowCounter struct {
+	comparison Comparison
+	// recent holds the most recent measurements, with measurement i at index i % len(recent).
+	recent []int
+	seen   int
+	count  int
+}
+
+func NewWindowCounter(window int, comparison Comparison) (*WindowCounter, error) {
+	if window < 1 {
+		return nil, fmt.Errorf("window must be at least 1, but was %d", window)
+	}
+	return &WindowCounter{comparison: comparison, recent: make([]int, window)}, nil
+}
+
+// Add records the next measurement, reporting whether it completes a window that is counted.
+func (counter *WindowCounter) Add(measurement int) (counted bool) {
+	// Consecutive windows share every measurement but the first of the earlier window and the last of the later
+	// window, so comparing those two measurements compares the sums of the windows.
+	slot := counter.seen % len(counter.recent)
+	if counter.seen >= len(counter.recent) {
+		counted = counter.comparison.matches(counter.recent[slot], measurement)
+	}
+	if counted {
+		counter.count++
+	}
+	counter.recent[slot] = measurement
+	counter.seen++
+	return
+}
+
+// Seen returns the number of measurements added so far.
+func (counter *WindowCounter) Seen() int {
+	return counter.seen
+}
+
+// Count returns the number of windows counted so far.
+func (counter *WindowCounter) Count() int {
+	return counter.count
+}
+
+// StreamMeasurements reads measurements one line at a time, adding each to the counter and then calling observe, if
+// given, with the index of the measurement and whether it completed a counted window. Measurements are not kept once
+// they have been added, so the input can be of any length.
+func StreamMeasurements(ctx context.Context, reader io.Reader, counter *WindowCounter, observe func(index int, counted bool)) error {
+	scanner := bufio.NewScanner(reader)
+	for line := 1; scanner.Scan(); line++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		measurement, err := parse.Int(scanner.Text(), parse.Position{Line: line, Column: 1})
+		if err != nil {
+			return fmt.Errorf("failed to read measurements. %w", err)
+		}
+		counted := counter.Add(measurement)
+		if observe != nil {
+			observe(line-1, counted)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read measurements. %w", err)
+	}
+	return nil
+}
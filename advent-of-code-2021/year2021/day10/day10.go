@@ -1,10 +1,13 @@
 package day10
 
 import (
+	"advent-of-code/answer"
+	"advent-of-code/registry"
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
-	"os"
+	"io"
 	"sort"
 )
 
@@ -74,43 +77,55 @@ var enclosures = enclosureSet{
 	},
 }
 
-func Part1(filePath string) (string, error) {
-	file, err := os.Open(filePath)
-	if err != nil {
-		return "", fmt.Errorf("could not open chunks file at %q. %w", filePath, err)
-	}
-	defer file.Close()
-	scanner := bufio.NewScanner(file)
+func init() {
+	registry.Register(2021, 10, 1, Part1FromReader, "input.txt")
+	registry.Register(2021, 10, 2, Part2FromReader, "input.txt")
+}
+
+func Part1(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part1FromReader)
+}
+
+func Part1FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	scanner := bufio.NewScanner(reader)
 
 	totalErrorScore := 0
 	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return answer.Answer{}, err
+		}
 		line := scanner.Text()
 		errorScore, err := getSyntaxErrorScore(line, enclosures)
 
 		if err != nil {
-			return "", err
+			return answer.Answer{}, err
 		}
 
 		totalErrorScore += errorScore
 	}
-	return fmt.Sprintf("Total error score: %d", totalErrorScore), nil
+	if err := scanner.Err(); err != nil {
+		return answer.Answer{}, fmt.Errorf("could not read chunks. %w", err)
+	}
+	return answer.New(totalErrorScore, "Total error score"), nil
 }
 
-func Part2(filePath string) (string, error) {
-	file, err := os.Open(filePath)
-	if err != nil {
-		return "", fmt.Errorf("could not open chunks file at %q. %w", filePath, err)
-	}
-	defer file.Close()
-	scanner := bufio.NewScanner(file)
+func Part2(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part2FromReader)
+}
+
+func Part2FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	scanner := bufio.NewScanner(reader)
 
 	var completionScores []int
 
 	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return answer.Answer{}, err
+		}
 		completionScore, corrupted, err := getCompletionScore(scanner.Text(), enclosures)
 
 		if err != nil {
-			return "", err
+			return answer.Answer{}, err
 		}
 
 		if corrupted {
@@ -120,9 +135,13 @@ func Part2(filePath string) (string, error) {
 		completionScores = append(completionScores, completionScore)
 	}
 
+	if err := scanner.Err(); err != nil {
+		return answer.Answer{}, fmt.Errorf("could not read chunks. %w", err)
+	}
+
 	sort.Ints(completionScores)
 	middleScore := completionScores[len(completionScores)/2]
-	return fmt.Sprintf("Middle completion score: %d", middleScore), nil
+	return answer.New(middleScore, "Middle completion score"), nil
 }
 
 func getSyntaxErrorScore(line string, enclosures enclosureSet) (score int, err error) {
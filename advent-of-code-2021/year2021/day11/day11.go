@@ -0,0 +1,140 @@
+package day11
+
+import (
+	"advent-of-code/answer"
+	"advent-of-code/grid"
+	"advent-of-code/parse"
+	"advent-of-code/registry"
+	"context"
+	"fmt"
+	"io"
+)
+
+type octopus struct {
+	energyLevel int
+	flashed     bool
+}
+
+func (octopus *octopus) incrementEnergyLevel() (flashed bool) {
+	octopus.energyLevel++
+	if octopus.energyLevel > 9 && !octopus.flashed {
+		octopus.flashed = true
+		flashed = true
+	}
+	return
+}
+
+func (octopus *octopus) resetIfFlashed() (wasReset bool) {
+	if octopus.flashed {
+		octopus.energyLevel = 0
+		octopus.flashed = false
+		wasReset = true
+	}
+	return
+}
+
+func newOctopus(energyLevel int) octopus {
+	return octopus{
+		energyLevel: energyLevel,
+		flashed:     false,
+	}
+}
+
+type octopusGrid struct {
+	*grid.Grid[*octopus]
+}
+
+func (octopuses *octopusGrid) step() (flashes int) {
+	for i := 0; i < octopuses.Len(); i++ {
+		octopuses.incrementEnergyAtLocation(i)
+	}
+	for _, octopus := range octopuses.Values() {
+		if octopus.resetIfFlashed() {
+			flashes++
+		}
+	}
+	return
+}
+
+func (octopuses *octopusGrid) incrementEnergyAtLocation(location int) {
+	octopus := octopuses.At(location)
+	flashed := octopus.incrementEnergyLevel()
+	if !flashed {
+		return
+	}
+
+	for _, neighbourLocation := range octopuses.NeighbourIndexes(location, grid.AllDirections) {
+		octopuses.incrementEnergyAtLocation(neighbourLocation)
+	}
+
+	return
+}
+
+func init() {
+	registry.Register(2021, 11, 1, Part1FromReader, "octopuses.txt")
+	registry.Register(2021, 11, 2, Part2FromReader, "octopuses.txt")
+}
+
+func Part1(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part1FromReader)
+}
+
+func Part1FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	octopuses, err := readOctopuses(reader)
+	if err != nil {
+		return answer.Answer{}, fmt.Errorf("could not retrieve octopuses: %w", err)
+	}
+
+	numberOfFlashes := 0
+	for i := 0; i < 100; i++ {
+		if err := ctx.Err(); err != nil {
+			return answer.Answer{}, err
+		}
+		numberOfFlashes += octopuses.step()
+	}
+
+	return answer.New(numberOfFlashes, "Total number of flashes"), nil
+}
+
+func Part2(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part2FromReader)
+}
+
+func Part2FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	octopuses, err := readOctopuses(reader)
+	if err != nil {
+		return answer.Answer{}, fmt.Errorf("could not retrieve octopuses: %w", err)
+	}
+
+	numberOfSteps := 1
+	for {
+		if err := ctx.Err(); err != nil {
+			return answer.Answer{}, err
+		}
+		if octopuses.step() == octopuses.Len() {
+			break
+		}
+		numberOfSteps++
+	}
+
+	return answer.New(numberOfSteps, "Total number of steps required to synchronise"), nil
+}
+
+func readOctopuses(reader io.Reader) (octopusGrid, error) {
+	energyLevels, width, err := parse.DigitGrid(reader)
+	if err != nil {
+		return octopusGrid{}, fmt.Errorf("could not read octopus grid: %w", err)
+	}
+
+	octopuses := make([]*octopus, len(energyLevels))
+	for index, energyLevel := range energyLevels {
+		octopus := newOctopus(energyLevel)
+		octopuses[index] = &octopus
+	}
+
+	octopusesGrid, err := grid.FromValues(octopuses, width)
+	if err != nil {
+		return octopusGrid{}, fmt.Errorf("could not read octopus grid: %w", err)
+	}
+	return octopusGrid{octopusesGrid}, nil
+}
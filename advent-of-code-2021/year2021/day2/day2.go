@@ -0,0 +1,186 @@
+package day2
+
+import (
+	"advent-of-code/answer"
+	"advent-of-code/registry"
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	forward = "forward"
+	up      = "up"
+	down    = "down"
+)
+
+// A Position is the submarine as originally understood, where up and down change its depth directly.
+type Position struct {
+	horizontal int
+	depth      int
+}
+
+func (position *Position) Horizontal() int {
+	return position.horizontal
+}
+
+func (position *Position) Depth() int {
+	return position.depth
+}
+
+func (position *Position) Instructions() Instructions {
+	return Instructions{
+		forward: func(amount int) {
+			position.horizontal += amount
+		},
+		down: func(amount int) {
+			position.depth += amount
+		},
+		up: func(amount int) {
+			position.depth -= amount
+		},
+	}
+}
+
+// A ReUnderstoodPosition is the submarine as re-understood, where up and down change its aim, and moving forward
+// changes its depth according to the aim.
+type ReUnderstoodPosition struct {
+	horizontal int
+	depth      int
+	aim        int
+}
+
+func (position *ReUnderstoodPosition) Horizontal() int {
+	return position.horizontal
+}
+
+func (position *ReUnderstoodPosition) Depth() int {
+	return position.depth
+}
+
+func (position *ReUnderstoodPosition) Aim() int {
+	return position.aim
+}
+
+func (position *ReUnderstoodPosition) Instructions() Instructions {
+	return Instructions{
+		forward: func(amount int) {
+			position.horizontal += amount
+			position.depth += position.aim * amount
+		},
+		down: func(amount int) {
+			position.aim += amount
+		},
+		up: func(amount int) {
+			position.aim -= amount
+		},
+	}
+}
+
+// A Command is a single instruction to the submarine, such as "forward 5".
+type Command struct {
+	Direction string
+	Amount    int
+	// Line is the line of the input that the command was read from.
+	Line int
+}
+
+// A ParseError describes a line of the input that is not a valid command.
+type ParseError struct {
+	Line   int
+	Text   string
+	Reason string
+}
+
+func (err *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %s in %q", err.Line, err.Reason, err.Text)
+}
+
+func init() {
+	registry.Register(2021, 2, 1, Part1FromReader, "commands.csv")
+	registry.Register(2021, 2, 2, Part2FromReader, "commands.csv")
+}
+
+func Part1(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part1FromReader)
+}
+
+func Part1FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	return followCommands(reader, &Position{})
+}
+
+func Part2(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part2FromReader)
+}
+
+func Part2FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	return followCommands(reader, newReUnderstoodPosition())
+}
+
+func followCommands(reader io.Reader, submarine Submarine) (answer.Answer, error) {
+	commands, _, err := ReadCommands(reader, submarine.Instructions(), false)
+	if err != nil {
+		return answer.Answer{}, err
+	}
+	if err := Run(submarine, commands); err != nil {
+		return answer.Answer{}, err
+	}
+	return FinalAnswer(submarine), nil
+}
+
+// FinalAnswer describes where the submarine has ended up.
+func FinalAnswer(submarine Submarine) answer.Answer {
+	return answer.New(submarine.Depth()*submarine.Horizontal(), "depth x horizontal")
+}
+
+// ReadCommands reads a command from every line of the input, where each command must have one of the directions in
+// the instructions. Normally the first line that is not a valid command is returned as a *ParseError, but if lenient
+// is set, invalid lines are skipped and returned alongside the valid commands.
+func ReadCommands(reader io.Reader, instructions Instructions, lenient bool) (commands []Command, skipped []*ParseError, err error) {
+	scanner := bufio.NewScanner(reader)
+	for line := 1; scanner.Scan(); line++ {
+		command, parseErr := parseCommand(scanner.Text(), line, instructions)
+		if parseErr != nil {
+			if !lenient {
+				return nil, nil, parseErr
+			}
+			skipped = append(skipped, parseErr)
+			continue
+		}
+		commands = append(commands, command)
+	}
+	if readError := scanner.Err(); readError != nil {
+		return nil, nil, fmt.Errorf("could not read commands: %w", readError)
+	}
+
+	return commands, skipped, nil
+}
+
+func parseCommand(text string, line int, instructions Instructions) (Command, *ParseError) {
+	components := strings.Split(text, " ")
+	if len(components) != 2 {
+		return Command{}, &ParseError{Line: line, Text: text, Reason: "expected a direction and an amount"}
+	}
+	direction := components[0]
+	amount, e := strconv.Atoi(components[1])
+	if e != nil {
+		return Command{}, &ParseError{Line: line, Text: text, Reason: "amount not numeric"}
+	}
+
+	if _, known := instructions[direction]; !known {
+		return Command{}, &ParseError{Line: line, Text: text, Reason: "unknown direction"}
+	}
+	return Command{Direction: direction, Amount: amount, Line: line}, nil
+}
+
+func newReUnderstoodPosition() *ReUnderstoodPosition {
+	position := ReUnderstoodPosition{
+		horizontal: 0,
+		depth:      0,
+		aim:        0,
+	}
+	return &position
+}
@@ -0,0 +1,160 @@
+package day2
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const malformedCommands = "forward 5\nsideways 2\ndown x\nup\ndown 3\n"
+
+func TestReadCommandsRejectsFirstInvalidLine(t *testing.T) {
+	_, _, err := ReadCommands(strings.NewReader(malformedCommands), (&Position{}).Instructions(), false)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected a parse error but got %v", err)
+	}
+	expected := ParseError{Line: 2, Text: "sideways 2", Reason: "unknown direction"}
+	if *parseErr != expected {
+		t.Errorf("expected %v but got %v", expected, *parseErr)
+	}
+}
+
+func TestReadCommandsLenientlySkipsInvalidLines(t *testing.T) {
+	commands, skipped, err := ReadCommands(strings.NewReader(malformedCommands), (&Position{}).Instructions(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedCommands := []Command{
+		{Direction: forward, Amount: 5, Line: 1},
+		{Direction: down, Amount: 3, Line: 5},
+	}
+	if !reflect.DeepEqual(commands, expectedCommands) {
+		t.Errorf("expected commands %v but got %v", expectedCommands, commands)
+	}
+
+	var skippedLines []int
+	for _, parseErr := range skipped {
+		skippedLines = append(skippedLines, parseErr.Line)
+	}
+	if !reflect.DeepEqual(skippedLines, []int{2, 3, 4}) {
+		t.Errorf("expected lines 2, 3 and 4 to be skipped but got %v", skippedLines)
+	}
+}
+
+// reversingSubmarine understands the original commands, plus "back", which moves it backwards.
+type reversingSubmarine struct {
+	*Position
+}
+
+func (submarine reversingSubmarine) Instructions() Instructions {
+	instructions := submarine.Position.Instructions()
+	instructions["back"] = func(amount int) {
+		instructions[forward](-amount)
+	}
+	return instructions
+}
+
+func TestInterpreterAcceptsNewCommands(t *testing.T) {
+	submarine := reversingSubmarine{&Position{}}
+	commands, _, err := ReadCommands(strings.NewReader("forward 5\ndown 2\nback 3\n"), submarine.Instructions(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Run(submarine, commands); err != nil {
+		t.Fatal(err)
+	}
+	if submarine.Horizontal() != 2 || submarine.Depth() != 2 {
+		t.Errorf("expected to finish at 2 across and 2 deep, but got %d and %d", submarine.Horizontal(), submarine.Depth())
+	}
+
+	if _, _, err := ReadCommands(strings.NewReader("back 3\n"), (&Position{}).Instructions(), false); err == nil {
+		t.Error("expected the original submarine not to understand back")
+	}
+}
+
+func TestInterpretationsMatchParts(t *testing.T) {
+	commands := []Command{
+		{Direction: forward, Amount: 5}, {Direction: down, Amount: 5}, {Direction: forward, Amount: 8},
+		{Direction: up, Amount: 3}, {Direction: down, Amount: 8}, {Direction: forward, Amount: 2},
+	}
+	expected := map[string]int{"original": 150, "aim": 900}
+	for name, newSubmarine := range Interpretations {
+		submarine := newSubmarine()
+		if err := Run(submarine, commands); err != nil {
+			t.Fatal(err)
+		}
+		if result := submarine.Depth() * submarine.Horizontal(); result != expected[name] {
+			t.Errorf("expected %s to give %d but got %d", name, expected[name], result)
+		}
+	}
+}
+
+func TestTraceRecordsEveryStep(t *testing.T) {
+	commands, _, err := ReadCommands(strings.NewReader("forward 5\ndown 5\nforward 8\nup 3\n"), (&ReUnderstoodPosition{}).Instructions(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trajectory, err := Trace(newReUnderstoodPosition(), commands)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Trajectory{
+		{Index: 1, Line: 1, Command: "forward 5", Horizontal: 5, Depth: 0, Aim: 0},
+		{Index: 2, Line: 2, Command: "down 5", Horizontal: 5, Depth: 0, Aim: 5},
+		{Index: 3, Line: 3, Command: "forward 8", Horizontal: 13, Depth: 40, Aim: 5},
+		{Index: 4, Line: 4, Command: "up 3", Horizontal: 13, Depth: 40, Aim: 2},
+	}
+	if !reflect.DeepEqual(trajectory, expected) {
+		t.Errorf("expected %v but got %v", expected, trajectory)
+	}
+
+	stats := trajectory.Stats()
+	if stats != (TrajectoryStats{Commands: 4, MaxDepth: 40, MaxDepthIndex: 3, MinAim: 0, MaxAim: 5}) {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+
+	var csv strings.Builder
+	if err := trajectory[:1].WriteCSV(&csv); err != nil {
+		t.Fatal(err)
+	}
+	if csv.String() != "index,line,command,horizontal,depth,aim\n1,1,forward 5,5,0,0\n" {
+		t.Errorf("unexpected CSV:\n%s", csv.String())
+	}
+}
+
+func TestWriteSVGDrawsEveryMovement(t *testing.T) {
+	trajectory := Trajectory{
+		{Index: 1, Command: "forward 5", Horizontal: 5, Depth: 0, Aim: 0},
+		{Index: 2, Command: "down 5", Horizontal: 5, Depth: 0, Aim: 5},
+		{Index: 3, Command: "forward 8", Horizontal: 13, Depth: 40, Aim: 5},
+	}
+	var svg strings.Builder
+	if err := WriteSVG(&svg, []Course{{Name: "aim & more", Trajectory: trajectory}}); err != nil {
+		t.Fatal(err)
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(svg.String()))
+	lines := 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("the SVG is not valid XML: %v\n%s", err, svg.String())
+		}
+		if element, ok := token.(xml.StartElement); ok && element.Name.Local == "line" {
+			lines++
+		}
+	}
+	// Changing the aim does not move the submarine, so only the two forward commands draw a line.
+	if lines != 2 {
+		t.Errorf("expected 2 lines but got %d:\n%s", lines, svg.String())
+	}
+}
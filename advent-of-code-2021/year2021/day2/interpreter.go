@@ -0,0 +1,60 @@
+package day2
+
+import (
+	"fmt"
+	"sort"
+)
+
+// A Submarine is the state that commands act on. Each way of understanding the commands is a different Submarine,
+// so new commands or new state can be added by implementing this interface, typically by embedding an existing
+// implementation and adding to its instructions.
+type Submarine interface {
+	Horizontal() int
+	Depth() int
+	// Instructions returns what each direction does to this submarine.
+	Instructions() Instructions
+}
+
+// Instructions map each direction onto a function that carries out a command in that direction with the given amount.
+type Instructions map[string]func(amount int)
+
+// Run carries out the commands in order. It fails if a command's direction is not understood by the submarine.
+func Run(submarine Submarine, commands []Command) error {
+	return run(submarine, commands, nil)
+}
+
+// run carries out the commands in order, calling observe, if given, after each one.
+func run(submarine Submarine, commands []Command, observe func(index int, command Command)) error {
+	instructions := submarine.Instructions()
+	for index, command := range commands {
+		instruction, known := instructions[command.Direction]
+		if !known {
+			return fmt.Errorf("line %d: the submarine does not understand %q", command.Line, command.Direction)
+		}
+		instruction(command.Amount)
+		if observe != nil {
+			observe(index, command)
+		}
+	}
+	return nil
+}
+
+// Interpretations creates a fresh submarine for each built-in way of understanding the commands, keyed by name.
+var Interpretations = map[string]func() Submarine{
+	"original": func() Submarine {
+		return &Position{}
+	},
+	"aim": func() Submarine {
+		return newReUnderstoodPosition()
+	},
+}
+
+// InterpretationNames returns the names of the built-in interpretations, in alphabetical order.
+func InterpretationNames() []string {
+	names := make([]string, 0, len(Interpretations))
+	for name := range Interpretations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
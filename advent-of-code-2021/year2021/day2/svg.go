@@ -0,0 +1,97 @@
+package day2
+
+import (
+	"advent-of-code/geometry"
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+)
+
+// A Course is a trajectory to draw, along with the name of the interpretation that produced it.
+type Course struct {
+	Name       string
+	Trajectory Trajectory
+}
+
+// The size of each course's panel in the SVG, and of the margin around the plot within it.
+const (
+	panelWidth  = 800
+	panelHeight = 300
+	plotMargin  = 40
+)
+
+// WriteSVG draws each course as a panel of a standalone SVG image, one above the other. Each panel plots depth
+// downwards against horizontal position across, with both axes scaled to fit the course, and colours each command's
+// movement by the submarine's aim afterwards, from blue at the lowest aim to red at the highest.
+func WriteSVG(writer io.Writer, courses []Course) error {
+	output := bufio.NewWriter(writer)
+	fmt.Fprintf(
+		output,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n",
+		panelWidth, panelHeight*len(courses), panelWidth, panelHeight*len(courses),
+	)
+	fmt.Fprintf(output, `<rect width="100%%" height="100%%" fill="white"/>`+"\n")
+	for index, course := range courses {
+		fmt.Fprintf(output, `<g transform="translate(0 %d)">`+"\n", index*panelHeight)
+		writePanel(output, course)
+		fmt.Fprintln(output, "</g>")
+	}
+	fmt.Fprintln(output, "</svg>")
+	return output.Flush()
+}
+
+func writePanel(output io.Writer, course Course) {
+	stats := course.Trajectory.Stats()
+	fmt.Fprintf(
+		output, `<text x="%d" y="%d" font-weight="bold">%s</text>`+"\n",
+		plotMargin, plotMargin/2, html.EscapeString(course.Name),
+	)
+	fmt.Fprintf(
+		output, `<text x="%d" y="%d" text-anchor="end">aim %d (blue) to %d (red)</text>`+"\n",
+		panelWidth-plotMargin, plotMargin/2, stats.MinAim, stats.MaxAim,
+	)
+
+	start := geometry.Point{}
+	bounds := geometry.NewBoundingBox(start)
+	for _, step := range course.Trajectory {
+		bounds = bounds.Include(geometry.Point{X: step.Horizontal, Y: step.Depth})
+	}
+	fmt.Fprintf(
+		output, `<text x="%d" y="%d">horizontal %d to %d, depth %d to %d</text>`+"\n",
+		plotMargin, panelHeight-plotMargin/4, bounds.Min.X, bounds.Max.X, bounds.Min.Y, bounds.Max.Y,
+	)
+
+	project := func(point geometry.Point) (x, y float64) {
+		return scale(point.X, bounds.Min.X, bounds.Max.X, panelWidth), scale(point.Y, bounds.Min.Y, bounds.Max.Y, panelHeight)
+	}
+	previous := start
+	for _, step := range course.Trajectory {
+		current := geometry.Point{X: step.Horizontal, Y: step.Depth}
+		if current != previous {
+			x1, y1 := project(previous)
+			x2, y2 := project(current)
+			fmt.Fprintf(
+				output, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s" stroke-width="2"/>`+"\n",
+				x1, y1, x2, y2, aimColour(step.Aim, stats.MinAim, stats.MaxAim),
+			)
+		}
+		previous = current
+	}
+}
+
+// scale maps a value between min and max onto the plot area of a panel dimension of the given size.
+func scale(value, min, max, size int) float64 {
+	if max == min {
+		return float64(size) / 2
+	}
+	return plotMargin + float64(value-min)/float64(max-min)*float64(size-2*plotMargin)
+}
+
+func aimColour(aim, minAim, maxAim int) string {
+	fraction := 0.0
+	if maxAim > minAim {
+		fraction = float64(aim-minAim) / float64(maxAim-minAim)
+	}
+	return fmt.Sprintf("hsl(%.0f, 80%%, 45%%)", 240*(1-fraction))
+}
@@ -0,0 +1,117 @@
+package day2
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// A Step records where the submarine was after carrying out a command.
+type Step struct {
+	// Index counts the commands carried out so far, so is 1 for the first command.
+	Index      int    `json:"index"`
+	Line       int    `json:"line"`
+	Command    string `json:"command"`
+	Horizontal int    `json:"horizontal"`
+	Depth      int    `json:"depth"`
+	// Aim is always 0 for submarines that do not aim.
+	Aim int `json:"aim"`
+}
+
+// A Trajectory is the course that the submarine followed, one step per command.
+type Trajectory []Step
+
+// An aimingSubmarine is a submarine whose movement depends on its aim.
+type aimingSubmarine interface {
+	Aim() int
+}
+
+// Trace carries out the commands in order, like Run, recording the submarine's position after each one.
+func Trace(submarine Submarine, commands []Command) (Trajectory, error) {
+	trajectory := make(Trajectory, 0, len(commands))
+	err := run(submarine, commands, func(index int, command Command) {
+		step := Step{
+			Index:      index + 1,
+			Line:       command.Line,
+			Command:    fmt.Sprintf("%s %d", command.Direction, command.Amount),
+			Horizontal: submarine.Horizontal(),
+			Depth:      submarine.Depth(),
+		}
+		if aiming, ok := submarine.(aimingSubmarine); ok {
+			step.Aim = aiming.Aim()
+		}
+		trajectory = append(trajectory, step)
+	})
+	return trajectory, err
+}
+
+// TrajectoryStats summarise a trajectory.
+type TrajectoryStats struct {
+	Commands int `json:"commands"`
+	MaxDepth int `json:"max_depth"`
+	// MaxDepthIndex is the index of the first step that reached the maximum depth, or 0 if there were no steps.
+	MaxDepthIndex int `json:"max_depth_index"`
+	MinAim        int `json:"min_aim"`
+	MaxAim        int `json:"max_aim"`
+}
+
+func (trajectory Trajectory) Stats() TrajectoryStats {
+	stats := TrajectoryStats{Commands: len(trajectory)}
+	for index, step := range trajectory {
+		if index == 0 || step.Depth > stats.MaxDepth {
+			stats.MaxDepth, stats.MaxDepthIndex = step.Depth, step.Index
+		}
+		if index == 0 || step.Aim < stats.MinAim {
+			stats.MinAim = step.Aim
+		}
+		if index == 0 || step.Aim > stats.MaxAim {
+			stats.MaxAim = step.Aim
+		}
+	}
+	return stats
+}
+
+func (stats TrajectoryStats) String() string {
+	return fmt.Sprintf(
+		"%d commands, max depth %d first reached by command %d, aim between %d and %d",
+		stats.Commands, stats.MaxDepth, stats.MaxDepthIndex, stats.MinAim, stats.MaxAim,
+	)
+}
+
+// WriteCSV writes one row per step, after a header row.
+func (trajectory Trajectory) WriteCSV(writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write([]string{"index", "line", "command", "horizontal", "depth", "aim"}); err != nil {
+		return err
+	}
+	for _, step := range trajectory {
+		row := []string{
+			strconv.Itoa(step.Index),
+			strconv.Itoa(step.Line),
+			step.Command,
+			strconv.Itoa(step.Horizontal),
+			strconv.Itoa(step.Depth),
+			strconv.Itoa(step.Aim),
+		}
+		if err := csvWriter.Write(row); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// WriteJSON writes the steps along with their stats.
+func (trajectory Trajectory) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(struct {
+		Stats TrajectoryStats `json:"stats"`
+		Steps Trajectory      `json:"steps"`
+	}{
+		Stats: trajectory.Stats(),
+		Steps: trajectory,
+	})
+}
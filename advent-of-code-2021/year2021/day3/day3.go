@@ -1,21 +1,33 @@
 package day3
 
 import (
+	"advent-of-code/answer"
+	"advent-of-code/registry"
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math"
-	"os"
 )
 
-func Part1(filePath string) (string, error) {
-	rawDiagnosticEntries, fileError := getDiagnosticOutput(filePath)
+func init() {
+	registry.Register(2021, 3, 1, Part1FromReader, "diagnostics.csv")
+	registry.Register(2021, 3, 2, Part2FromReader, "diagnostics.csv")
+}
+
+func Part1(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part1FromReader)
+}
+
+func Part1FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	rawDiagnosticEntries, fileError := getDiagnosticOutput(reader)
 	if fileError != nil {
-		return "", fmt.Errorf("Failed to read diagnostic output. %w", fileError)
+		return answer.Answer{}, fmt.Errorf("Failed to read diagnostic output. %w", fileError)
 	}
 	diagnosticEntries, err := toBoolArrays(rawDiagnosticEntries)
 	if err != nil {
-		return "", fmt.Errorf("failed to read diagnostic output. %w", err)
+		return answer.Answer{}, fmt.Errorf("failed to read diagnostic output. %w", err)
 	}
 
 	rawGammaRate := getRawGammaRate(diagnosticEntries)
@@ -24,17 +36,23 @@ func Part1(filePath string) (string, error) {
 	gammaRate := boolsToInt(rawGammaRate)
 	epsilonRate := boolsToInt(rawEpsilonRate)
 
-	return fmt.Sprintf("Power consumption: %d", gammaRate*epsilonRate), nil
+	return answer.New(gammaRate*epsilonRate, "Power consumption").
+		With("gamma rate", gammaRate).
+		With("epsilon rate", epsilonRate), nil
 }
 
-func Part2(filePath string) (string, error) {
-	rawDiagnosticEntries, fileError := getDiagnosticOutput(filePath)
+func Part2(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part2FromReader)
+}
+
+func Part2FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	rawDiagnosticEntries, fileError := getDiagnosticOutput(reader)
 	if fileError != nil {
-		return "", fmt.Errorf("Failed to read diagnostic output. %w", fileError)
+		return answer.Answer{}, fmt.Errorf("Failed to read diagnostic output. %w", fileError)
 	}
 	diagnosticEntries, err := toBoolArrays(rawDiagnosticEntries)
 	if err != nil {
-		return "", fmt.Errorf("failed to read diagnostic output. %w", err)
+		return answer.Answer{}, fmt.Errorf("failed to read diagnostic output. %w", err)
 	}
 
 	rawOxygenGeneratorRating := getRawOxygenGeneratorRatings(diagnosticEntries)
@@ -43,22 +61,21 @@ func Part2(filePath string) (string, error) {
 	oxygenGeneratorRating := boolsToInt(rawOxygenGeneratorRating)
 	co2ScrubberRating := boolsToInt(rawCO2ScrubberRating)
 
-	return fmt.Sprintf("Life support rating: %d", oxygenGeneratorRating*co2ScrubberRating), nil
+	return answer.New(oxygenGeneratorRating*co2ScrubberRating, "Life support rating").
+		With("oxygen generator rating", oxygenGeneratorRating).
+		With("CO2 scrubber rating", co2ScrubberRating), nil
 }
 
-func getDiagnosticOutput(filePath string) (*[]string, error) {
-	file, readError := os.Open(filePath)
-	if readError != nil {
-		return nil, fmt.Errorf("failed to read diagnostic file. %w", readError)
-	}
-	defer file.Close()
-
+func getDiagnosticOutput(reader io.Reader) (*[]string, error) {
 	var commands []string
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(reader)
 
 	for scanner.Scan() {
 		commands = append(commands, scanner.Text())
 	}
+	if readError := scanner.Err(); readError != nil {
+		return nil, fmt.Errorf("failed to read diagnostic output. %w", readError)
+	}
 
 	return &commands, nil
 }
@@ -1,12 +1,14 @@
 package day4
 
 import (
-	"bufio"
+	"advent-of-code/answer"
+	"advent-of-code/parse"
+	"advent-of-code/registry"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"math"
-	"os"
-	"strconv"
-	"strings"
 )
 
 type boardElement struct {
@@ -19,34 +21,53 @@ type board struct {
 	elements *[]*boardElement
 }
 
-func Part1(filePath string) (string, error) {
-	inputs, boards, err := readInputFile(filePath)
+func init() {
+	registry.Register(2021, 4, 1, Part1FromReader, "game.txt")
+	registry.Register(2021, 4, 2, Part2FromReader, "game.txt")
+}
+
+func Part1(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part1FromReader)
+}
+
+func Part1FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	inputs, boards, err := readInput(reader)
 	if err != nil {
-		return "", fmt.Errorf("failed to read input file. %w", err)
+		return answer.Answer{}, fmt.Errorf("failed to read input. %w", err)
 	}
 
 	for _, input := range *inputs {
+		if err := ctx.Err(); err != nil {
+			return answer.Answer{}, err
+		}
 		for _, board := range *boards {
 			hasWon, winningScore := board.mark(input)
 			if hasWon {
-				return fmt.Sprintf("Winning score: %d", winningScore), nil
+				return answer.New(winningScore, "Winning score"), nil
 			}
 		}
 	}
 
-	return "No board will win", nil
+	return answer.None("No board will win"), nil
+}
+
+func Part2(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part2FromReader)
 }
 
-func Part2(filePath string) (string, error) {
-	inputs, boards, err := readInputFile(filePath)
+func Part2FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	inputs, boards, err := readInput(reader)
 	if err != nil {
-		return "", fmt.Errorf("failed to read input file. %w", err)
+		return answer.Answer{}, fmt.Errorf("failed to read input. %w", err)
 	}
 
 	remainingBoards := *boards
 	boardsNotWon := len(remainingBoards)
 
 	for _, input := range *inputs {
+		if err := ctx.Err(); err != nil {
+			return answer.Answer{}, err
+		}
 		for index, board := range remainingBoards {
 			if board == nil {
 				continue
@@ -54,7 +75,7 @@ func Part2(filePath string) (string, error) {
 			hasWon, winningScore := board.mark(input)
 			if hasWon {
 				if boardsNotWon == 1 {
-					return fmt.Sprintf("Winning score: %d", winningScore), nil
+					return answer.New(winningScore, "Winning score"), nil
 				}
 				remainingBoards[index] = nil
 				boardsNotWon--
@@ -62,24 +83,24 @@ func Part2(filePath string) (string, error) {
 		}
 	}
 
-	return "No board will win", nil
+	return answer.None("No board will win"), nil
 }
 
-func readInputFile(filePath string) (*[]int, *[]*board, error) {
-	file, err := os.Open(filePath)
+func readInput(reader io.Reader) (*[]int, *[]*board, error) {
+	blocks, err := parse.Blocks(reader)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to open input file %q. %w", filePath, err)
+		return nil, nil, err
+	}
+	if len(blocks) == 0 {
+		return nil, nil, errors.New("input is empty")
 	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
 
-	drawnNumbers, err := getDrawnNumbers(scanner)
+	drawnNumbers, err := getDrawnNumbers(blocks[0])
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to read drawn numbers. %w", err)
 	}
 
-	boards, err := createBoards(scanner)
+	boards, err := createBoards(blocks[1:])
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create game boards. %w", err)
 	}
@@ -87,65 +108,36 @@ func readInputFile(filePath string) (*[]int, *[]*board, error) {
 	return drawnNumbers, boards, nil
 }
 
-func getDrawnNumbers(scanner *bufio.Scanner) (*[]int, error) {
-	scanner.Scan()
-	input := scanner.Text()
-	rawDrawnNumbers := strings.Split(input, ",")
-	return stringArrayToIntArray(&rawDrawnNumbers)
-}
-
-func stringArrayToIntArray(stringArray *[]string) (*[]int, error) {
-	result := make([]int, len(*stringArray))
-	for index, value := range *stringArray {
-		number, err := strconv.Atoi(value)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert value %q to integer. %w", value, err)
-		}
-		result[index] = number
+func getDrawnNumbers(block parse.Block) (*[]int, error) {
+	drawnNumbers, err := parse.Ints(block.Lines[0], ",", block.Position(0))
+	if err != nil {
+		return nil, err
 	}
-	return &result, nil
+	return &drawnNumbers, nil
 }
 
-func createBoards(scanner *bufio.Scanner) (*[]*board, error) {
-	var boards []*board
-	for {
-		board, err := createNextBoard(scanner)
+func createBoards(blocks []parse.Block) (*[]*board, error) {
+	boards := make([]*board, len(blocks))
+	for index, block := range blocks {
+		board, err := createBoard(block)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create board. %w", err)
 		}
-		if board == nil {
-			return &boards, nil
-		}
-		boards = append(boards, board)
+		boards[index] = board
 	}
+	return &boards, nil
 }
 
-func createNextBoard(scanner *bufio.Scanner) (*board, error) {
-	boardRead := false
-	var rawBoardValues []string
-	for scanner.Scan() {
-		text := scanner.Text()
-		if text == "" {
-			if boardRead {
-				break
-			}
-		} else {
-			boardRead = true
-			rawRowValues := strings.Fields(text)
-			rawBoardValues = append(rawBoardValues, rawRowValues...)
+func createBoard(block parse.Block) (*board, error) {
+	var boardValues []int
+	for index, line := range block.Lines {
+		rowValues, err := parse.Ints(line, "", block.Position(index))
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert values to board. %w", err)
 		}
+		boardValues = append(boardValues, rowValues...)
 	}
-
-	if boardRead == false {
-		return nil, nil
-	}
-
-	boardValues, err := stringArrayToIntArray(&rawBoardValues)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert values to board. %w", err)
-	}
-	board := newBoard(boardValues)
-	return board, nil
+	return newBoard(&boardValues), nil
 }
 
 func newBoard(values *[]int) *board {
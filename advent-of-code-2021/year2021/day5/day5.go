@@ -0,0 +1,165 @@
+package day5
+
+import (
+	"advent-of-code/answer"
+	"advent-of-code/geometry"
+	"advent-of-code/parse"
+	"advent-of-code/registry"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+type vent struct {
+	geometry.Segment
+}
+
+func init() {
+	registry.Register(2021, 5, 1, Part1FromReader, "vent_coordinates.txt")
+	registry.Register(2021, 5, 2, Part2FromReader, "vent_coordinates.txt")
+}
+
+func Part1(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part1FromReader)
+}
+
+func Part1FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	vents, err := getVents(reader)
+	if err != nil {
+		return answer.Answer{}, fmt.Errorf("failed to retrieve vents. %w", err)
+	}
+
+	coordinateCoverCount := make(map[geometry.Point]int)
+	for _, vent := range *vents {
+		if err := ctx.Err(); err != nil {
+			return answer.Answer{}, err
+		}
+		coveredCoordinates, err := vent.getHorizontalAndVerticalCoveredCoordinates()
+		if err == nil {
+			for _, coveredSpot := range *coveredCoordinates {
+				count, found := coordinateCoverCount[coveredSpot]
+				if !found {
+					coordinateCoverCount[coveredSpot] = 1
+				} else {
+					coordinateCoverCount[coveredSpot] = count + 1
+				}
+			}
+		}
+	}
+
+	overlapCount := 0
+	for _, value := range coordinateCoverCount {
+		if value > 1 {
+			overlapCount++
+		}
+	}
+
+	return answer.New(overlapCount, "Overlapping points"), nil
+}
+
+func Part2(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part2FromReader)
+}
+
+func Part2FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	vents, err := getVents(reader)
+	if err != nil {
+		return answer.Answer{}, fmt.Errorf("failed to retrieve vents. %w", err)
+	}
+
+	coordinateCoverCount := make(map[geometry.Point]int)
+	for _, vent := range *vents {
+		if err := ctx.Err(); err != nil {
+			return answer.Answer{}, err
+		}
+		coveredCoordinates, err := vent.getCoveredCoordinates()
+		if err == nil {
+			for _, coveredSpot := range *coveredCoordinates {
+				count, found := coordinateCoverCount[coveredSpot]
+				if !found {
+					coordinateCoverCount[coveredSpot] = 1
+				} else {
+					coordinateCoverCount[coveredSpot] = count + 1
+				}
+			}
+		}
+	}
+
+	overlapCount := 0
+	for _, value := range coordinateCoverCount {
+		if value > 1 {
+			overlapCount++
+		}
+	}
+
+	return answer.New(overlapCount, "Overlapping points"), nil
+}
+
+func getVents(reader io.Reader) (*[]*vent, error) {
+	pairs, err := parse.Pairs(reader, " -> ")
+	if err != nil {
+		return nil, err
+	}
+
+	vents := make([]*vent, len(pairs))
+	for index, pair := range pairs {
+		vent, ventErr := newVent(pair)
+		if ventErr != nil {
+			return nil, fmt.Errorf("failed to initialise vent. %w", ventErr)
+		}
+		vents[index] = vent
+	}
+
+	return &vents, nil
+}
+
+func newVent(pair parse.Pair) (*vent, error) {
+	start, err := newCoordinate(pair.Left, pair.LeftPosition)
+	if err != nil {
+		return nil, err
+	}
+	end, err := newCoordinate(pair.Right, pair.RightPosition)
+	if err != nil {
+		return nil, err
+	}
+
+	vent := vent{
+		Segment: geometry.Segment{Start: *start, End: *end},
+	}
+
+	return &vent, nil
+}
+
+func newCoordinate(input string, position parse.Position) (*geometry.Point, error) {
+	values, err := parse.Ints(input, ",", position)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 2 {
+		return nil, fmt.Errorf("%s: incorrect number of elements in coordinates %q", position, input)
+	}
+
+	coordinates := geometry.Point{
+		X: values[0],
+		Y: values[1],
+	}
+
+	return &coordinates, nil
+}
+
+func (vent *vent) getHorizontalAndVerticalCoveredCoordinates() (*[]geometry.Point, error) {
+	if !vent.IsHorizontal() && !vent.IsVertical() {
+		return nil, errors.New("vent is not perfectly vertical or horizontal")
+	}
+	coveredCoordinates := vent.Points()
+	return &coveredCoordinates, nil
+}
+
+func (vent *vent) getCoveredCoordinates() (*[]geometry.Point, error) {
+	if !vent.IsHorizontal() && !vent.IsVertical() && !vent.IsDiagonal() {
+		return nil, errors.New("vent is not perfectly vertical, horizontal or diagonal")
+	}
+	coveredCoordinates := vent.Points()
+	return &coveredCoordinates, nil
+}
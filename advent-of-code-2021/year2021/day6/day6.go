@@ -1,11 +1,12 @@
 package day6
 
 import (
-	"bufio"
+	"advent-of-code/answer"
+	"advent-of-code/parse"
+	"advent-of-code/registry"
+	"context"
 	"fmt"
-	"os"
-	"strconv"
-	"strings"
+	"io"
 )
 
 const (
@@ -81,54 +82,65 @@ func (group *shoalGroup) advanceDay() int64 {
 	return 0
 }
 
-func Part1(filePath string) (string, error) {
-	fish, err := getInitialFish(filePath)
+func init() {
+	registry.Register(2021, 6, 1, Part1FromReader, "lanternfish.csv")
+	registry.Register(2021, 6, 2, Part2FromReader, "lanternfish.csv")
+}
+
+func Part1(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part1FromReader)
+}
+
+func Part1FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	fish, err := getInitialFish(reader)
 	if err != nil {
-		return "", fmt.Errorf("failed to retrieve lanternfish. %w", err)
+		return answer.Answer{}, fmt.Errorf("failed to retrieve lanternfish. %w", err)
 	}
 
 	days := 80
-	return fmt.Sprintf("Number of fish after %d days: %d", days, getNumberOfLanternfishAfterDays(fish, days)), nil
+	numberOfFish, err := getNumberOfLanternfishAfterDays(ctx, fish, days)
+	if err != nil {
+		return answer.Answer{}, err
+	}
+	return answer.New(numberOfFish, fmt.Sprintf("Number of fish after %d days", days)), nil
+}
+
+func Part2(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part2FromReader)
 }
 
-func Part2(filePath string) (string, error) {
-	fish, err := getInitialFish(filePath)
+func Part2FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	fish, err := getInitialFish(reader)
 	if err != nil {
-		return "", fmt.Errorf("failed to retrieve lanternfish. %w", err)
+		return answer.Answer{}, fmt.Errorf("failed to retrieve lanternfish. %w", err)
 	}
 
 	days := 256
-	return fmt.Sprintf("Number of fish after %d days: %d", days, getNumberOfLanternfishAfterDays(fish, days)), nil
+	numberOfFish, err := getNumberOfLanternfishAfterDays(ctx, fish, days)
+	if err != nil {
+		return answer.Answer{}, err
+	}
+	return answer.New(numberOfFish, fmt.Sprintf("Number of fish after %d days", days)), nil
 }
 
-func getNumberOfLanternfishAfterDays(shoal *shoal, days int) int64 {
+func getNumberOfLanternfishAfterDays(ctx context.Context, shoal *shoal, days int) (int64, error) {
 	for i := 0; i < days; i++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
 		shoal.advanceDay()
 	}
-	return shoal.getNumberOfFish()
+	return shoal.getNumberOfFish(), nil
 }
 
-func getInitialFish(filePath string) (*shoal, error) {
-	file, err := os.Open(filePath)
+func getInitialFish(reader io.Reader) (*shoal, error) {
+	fish, err := parse.CommaSeparatedInts(reader)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open lanternfish file %q. %w", filePath, err)
+		return nil, err
 	}
-	defer file.Close()
 
-	scanner := bufio.NewScanner(file)
-	scanner.Scan()
-	return createShoal(scanner.Text())
-}
-
-func createShoal(input string) (*shoal, error) {
-	rawInputs := strings.Split(input, ",")
 	shoal := newShoal()
-
-	for _, input := range rawInputs {
-		daysUntilOffspringDue, err := strconv.Atoi(input)
-		if err != nil {
-			return nil, err
-		}
+	for _, daysUntilOffspringDue := range fish {
 		shoal.addSingleFish(daysUntilOffspringDue)
 	}
 	return shoal, nil
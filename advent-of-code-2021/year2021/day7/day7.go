@@ -1,17 +1,27 @@
 package day7
 
 import (
-	"bufio"
+	"advent-of-code/answer"
+	"advent-of-code/parse"
+	"advent-of-code/registry"
+	"context"
 	"fmt"
-	"os"
-	"strconv"
-	"strings"
+	"io"
 )
 
-func Part1(filePath string) (string, error) {
-	positions, err := getCrabPositions(filePath)
+func init() {
+	registry.Register(2021, 7, 1, Part1FromReader, "crab_positions.csv")
+	registry.Register(2021, 7, 2, Part2FromReader, "crab_positions.csv")
+}
+
+func Part1(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part1FromReader)
+}
+
+func Part1FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	positions, err := getCrabPositions(reader)
 	if err != nil {
-		return "", fmt.Errorf("failed to read crab positions. %w", err)
+		return answer.Answer{}, fmt.Errorf("failed to read crab positions. %w", err)
 	}
 
 	min, _ := min(positions)
@@ -20,19 +30,26 @@ func Part1(filePath string) (string, error) {
 	var fuelRequired *int
 
 	for i := min; i <= max; i++ {
+		if err := ctx.Err(); err != nil {
+			return answer.Answer{}, err
+		}
 		absoluteDifferences := sumAbsoluteDifferences(positions, i)
 		if fuelRequired == nil || absoluteDifferences < *fuelRequired {
 			fuelRequired = &absoluteDifferences
 			bestPosition = i
 		}
 	}
-	return fmt.Sprintf("Best position: %d. Fuel required: %d", bestPosition, *fuelRequired), nil
+	return answer.New(*fuelRequired, "Fuel required").With("best position", bestPosition), nil
+}
+
+func Part2(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part2FromReader)
 }
 
-func Part2(filePath string) (string, error) {
-	positions, err := getCrabPositions(filePath)
+func Part2FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	positions, err := getCrabPositions(reader)
 	if err != nil {
-		return "", fmt.Errorf("failed to read crab positions. %w", err)
+		return answer.Answer{}, fmt.Errorf("failed to read crab positions. %w", err)
 	}
 
 	min, _ := min(positions)
@@ -41,13 +58,16 @@ func Part2(filePath string) (string, error) {
 	var fuelRequired *int
 
 	for i := min; i <= max; i++ {
+		if err := ctx.Err(); err != nil {
+			return answer.Answer{}, err
+		}
 		absoluteDifferences := sumAbsoluteDifferencesWithReunderstoodFuelCosts(positions, i)
 		if fuelRequired == nil || absoluteDifferences < *fuelRequired {
 			fuelRequired = &absoluteDifferences
 			bestPosition = i
 		}
 	}
-	return fmt.Sprintf("Best position: %d. Fuel required: %d", bestPosition, *fuelRequired), nil
+	return answer.New(*fuelRequired, "Fuel required").With("best position", bestPosition), nil
 }
 
 func min(values *[]int) (int, bool) {
@@ -109,32 +129,10 @@ func abs(value int) int {
 	return value
 }
 
-func getCrabPositions(filePath string) (*[]int, error) {
-	file, err := os.Open(filePath)
+func getCrabPositions(reader io.Reader) (*[]int, error) {
+	positions, err := parse.CommaSeparatedInts(reader)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open crab file %q. %w", filePath, err)
-	}
-	defer file.Close()
-	scanner := bufio.NewScanner(file)
-	scanner.Scan()
-	text := scanner.Text()
-
-	return parsePositions(text)
-}
-
-func parsePositions(text string) (*[]int, error) {
-	rawPositions := strings.Split(text, ",")
-	return stringSliceToIntSlice(&rawPositions)
-}
-
-func stringSliceToIntSlice(stringSlice *[]string) (*[]int, error) {
-	intSlice := make([]int, len(*stringSlice))
-	for index, rawValue := range *stringSlice {
-		value, err := strconv.Atoi(rawValue)
-		if err != nil {
-			return nil, err
-		}
-		intSlice[index] = value
+		return nil, err
 	}
-	return &intSlice, nil
+	return &positions, nil
 }
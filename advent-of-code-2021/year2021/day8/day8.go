@@ -1,10 +1,13 @@
 package day8
 
 import (
+	"advent-of-code/answer"
+	"advent-of-code/registry"
 	"bufio"
+	"context"
 	"fmt"
+	"io"
 	"math"
-	"os"
 	"strings"
 )
 
@@ -46,16 +49,22 @@ func (display display) getSignalPatternsForDecodedValue(number int) (signalPatte
 	return ""
 }
 
-func Part1(filePath string) (string, error) {
-	file, err := os.Open(filePath)
-	if err != nil {
-		return "", fmt.Errorf("failed to read signal patterns file. %w", err)
-	}
-	defer file.Close()
+func init() {
+	registry.Register(2021, 8, 1, Part1FromReader, "signal_patterns.txt")
+	registry.Register(2021, 8, 2, Part2FromReader, "signal_patterns.txt")
+}
 
+func Part1(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part1FromReader)
+}
+
+func Part1FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
 	numberOfKnownValuesInOutput := 0
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(reader)
 	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return answer.Answer{}, err
+		}
 		entry := parseEntry(scanner.Text())
 		for _, output := range entry.outputs {
 			if isKnownNumber(output.signalPattern) {
@@ -63,23 +72,31 @@ func Part1(filePath string) (string, error) {
 			}
 		}
 	}
-	return fmt.Sprintf("Number of 1, 4, 7 or 8s in the output: %d", numberOfKnownValuesInOutput), nil
+	if err := scanner.Err(); err != nil {
+		return answer.Answer{}, fmt.Errorf("failed to read signal patterns. %w", err)
+	}
+	return answer.New(numberOfKnownValuesInOutput, "Number of 1, 4, 7 or 8s in the output"), nil
 }
 
-func Part2(filePath string) (string, error) {
-	file, err := os.Open(filePath)
-	if err != nil {
-		return "", fmt.Errorf("failed to read signal patterns file. %w", err)
-	}
+func Part2(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part2FromReader)
+}
 
-	scanner := bufio.NewScanner(file)
+func Part2FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	scanner := bufio.NewScanner(reader)
 
 	sumOutputs := 0
 	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return answer.Answer{}, err
+		}
 		entry := parseEntry(scanner.Text())
 		sumOutputs += entry.decipher()
 	}
-	return fmt.Sprintf("Sum of outputs: %d", sumOutputs), nil
+	if err := scanner.Err(); err != nil {
+		return answer.Answer{}, fmt.Errorf("failed to read signal patterns. %w", err)
+	}
+	return answer.New(sumOutputs, "Sum of outputs"), nil
 }
 
 func parseEntry(rawEntry string) (d display) {
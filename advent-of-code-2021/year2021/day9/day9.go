@@ -0,0 +1,181 @@
+package day9
+
+import (
+	"advent-of-code/answer"
+	"advent-of-code/grid"
+	"advent-of-code/parse"
+	"advent-of-code/registry"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+)
+
+type heightmap struct {
+	*grid.Grid[int]
+}
+
+func init() {
+	registry.Register(2021, 9, 1, Part1FromReader, "heightmap.txt")
+	registry.Register(2021, 9, 2, Part2FromReader, "heightmap.txt")
+}
+
+func Part1(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part1FromReader)
+}
+
+func Part1FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	hmap, err := readMap(reader)
+	if err != nil {
+		return answer.Answer{}, err
+	}
+	return answer.New(hmap.getTotalOfRiskLevels(), "Total of risk levels"), nil
+}
+
+func Part2(ctx context.Context, filePath string) (answer.Answer, error) {
+	return registry.SolveFile(ctx, filePath, Part2FromReader)
+}
+
+func Part2FromReader(ctx context.Context, reader io.Reader) (answer.Answer, error) {
+	hmap, err := readMap(reader)
+	if err != nil {
+		return answer.Answer{}, err
+	}
+
+	basins, err := hmap.getBasins(ctx)
+	if err != nil {
+		return answer.Answer{}, err
+	}
+	basinLengths := make([]int, len(basins))
+	for index, basin := range basins {
+		basinLengths[index] = len(basin)
+	}
+
+	sort.Ints(basinLengths)
+	largestBasinSize := basinLengths[len(basinLengths)-1]
+	secondLargestBasinSize := basinLengths[len(basinLengths)-2]
+	thirdLargestBasinSize := basinLengths[len(basinLengths)-3]
+
+	return answer.New(largestBasinSize*secondLargestBasinSize*thirdLargestBasinSize, "Total size of largest three basins").
+		With("largest basin sizes", []int{largestBasinSize, secondLargestBasinSize, thirdLargestBasinSize}).
+		With("number of basins", len(basins)), nil
+}
+
+func (heightmap heightmap) isLowPoint(heightmapIndex int) bool {
+	point := heightmap.At(heightmapIndex)
+	for _, neighbourIndex := range heightmap.NeighbourIndexes(heightmapIndex, grid.Orthogonal) {
+		if heightmap.At(neighbourIndex) <= point {
+			return false
+		}
+	}
+	return true
+}
+
+func (heightmap heightmap) getBasins(ctx context.Context) (basins [][]int, err error) {
+	unassignedPointsWithinBasin := heightmap.getPointsWithinAnyBasin()
+
+	for len(unassignedPointsWithinBasin) > 0 {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		basin, u := heightmap.getIndexOfPointsInBasinFromIndex(unassignedPointsWithinBasin[0], unassignedPointsWithinBasin)
+		basins = append(basins, basin)
+		unassignedPointsWithinBasin = u
+	}
+	return
+}
+
+func (heightmap heightmap) getIndexOfPointsInBasinFromIndex(heightmapIndex int, remainingPointsWithinAnyBasin []int) ([]int, []int) {
+	var points []int
+	points = append(points, heightmapIndex)
+	remainingPointsWithinAnyBasin = removeFromSlice(remainingPointsWithinAnyBasin, heightmapIndex)
+
+	p := heightmap.getIndexesOfPointsInBasinDirectlyAround(heightmapIndex, remainingPointsWithinAnyBasin)
+	for _, point := range p {
+		remainingPointsWithinAnyBasin = removeFromSlice(remainingPointsWithinAnyBasin, point)
+		//points = append(points, point)
+	}
+	for _, point := range p {
+		var p2 []int
+		p2, remainingPointsWithinAnyBasin = heightmap.getIndexOfPointsInBasinFromIndex(point, remainingPointsWithinAnyBasin)
+		points = append(points, p2...)
+	}
+
+	return points, remainingPointsWithinAnyBasin
+}
+
+func (heightmap heightmap) getIndexesOfPointsInBasinDirectlyAround(heightmapIndex int, pointsWithinAnyBasin []int) (points []int) {
+	for _, neighbourIndex := range heightmap.NeighbourIndexes(heightmapIndex, grid.Orthogonal) {
+		if sliceContains(pointsWithinAnyBasin, neighbourIndex) {
+			points = append(points, neighbourIndex)
+		}
+	}
+	return
+}
+
+func (heightmap heightmap) getPointsWithinAnyBasin() (pointsWithinAnyBasin []int) {
+	for index, height := range heightmap.Values() {
+		if height != 9 {
+			pointsWithinAnyBasin = append(pointsWithinAnyBasin, index)
+		}
+	}
+	return
+}
+
+func (heightmap heightmap) getRiskLevels() (riskLevels []int) {
+	for index, height := range heightmap.Values() {
+		if heightmap.isLowPoint(index) {
+			riskLevels = append(riskLevels, 1+height)
+		}
+	}
+	return
+}
+
+func (heightmap heightmap) getTotalOfRiskLevels() (total int) {
+	riskLevels := heightmap.getRiskLevels()
+	for _, riskLevel := range riskLevels {
+		total += riskLevel
+	}
+	return
+}
+
+func readMap(reader io.Reader) (hmap heightmap, err error) {
+	heights, width, err := parse.DigitGrid(reader)
+	if err != nil {
+		err = fmt.Errorf("could not read heightmap. %w", err)
+		return
+	}
+
+	heightGrid, err := grid.FromValues(heights, width)
+	if err != nil {
+		err = fmt.Errorf("could not read heightmap. %w", err)
+		return
+	}
+
+	hmap = heightmap{heightGrid}
+	return
+}
+
+func sliceContains(slice []int, value int) bool {
+	_, found := sliceIndexOf(slice, value)
+	return found
+}
+
+func sliceIndexOf(slice []int, value int) (indexOfElement int, found bool) {
+	for index, element := range slice {
+		if element == value {
+			indexOfElement = index
+			found = true
+			return
+		}
+	}
+	return
+}
+
+func removeFromSlice(slice []int, value int) []int {
+	index, found := sliceIndexOf(slice, value)
+	if found {
+		return append(slice[:index], slice[index+1:]...)
+	}
+	return slice
+}
@@ -0,0 +1,17 @@
+// Package year2021 registers every day of Advent of Code 2021 with the registry.
+package year2021
+
+// Importing a day package registers its solutions with the registry, so new days only need adding here.
+import (
+	_ "advent-of-code/year2021/day1"
+	_ "advent-of-code/year2021/day10"
+	_ "advent-of-code/year2021/day11"
+	_ "advent-of-code/year2021/day2"
+	_ "advent-of-code/year2021/day3"
+	_ "advent-of-code/year2021/day4"
+	_ "advent-of-code/year2021/day5"
+	_ "advent-of-code/year2021/day6"
+	_ "advent-of-code/year2021/day7"
+	_ "advent-of-code/year2021/day8"
+	_ "advent-of-code/year2021/day9"
+)
@@ -0,0 +1,7 @@
+package main
+
+// Importing a year package registers the solutions for each of its days with the registry, so new years only need
+// adding here.
+import (
+	_ "advent-of-code/year2021"
+)